@@ -4,7 +4,8 @@ specific cancellation or deadline behavior without the boilerplate. The package
 focuses on one-liner constructors so test authors can express failure scenarios
 clearly and consistently. Start with Cancelled for immediate
 context.Canceled states and layer in additional helpers as your tests grow more
-complex.
+complex. When the question is whether code cleans up after itself, hand it a
+Tree and assert that every derived context was cancelled.
 */
 package fakectx
 
@@ -62,3 +62,16 @@ func ExampleCancelledWithCallback() {
 	// Output:
 	// Cancelled callback called
 }
+
+func ExampleNewTree() {
+	tree := NewTree()
+
+	_, cancel := context.WithTimeout(tree, time.Minute)
+	fmt.Println("Leaked:", len(tree.Leaked()))
+
+	cancel()
+	fmt.Println("Leaked:", len(tree.Leaked()))
+	// Output:
+	// Leaked: 1
+	// Leaked: 0
+}
@@ -0,0 +1,196 @@
+package fakectx
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// releaseGrace is how long RequireNoLeakedChildren waits for live children
+// to be released before reporting them.
+const releaseGrace = 100 * time.Millisecond
+
+// Child describes a context derived directly from a Tree.
+type Child struct {
+	// ID is the order in which the child was derived, starting at 1.
+	ID int
+
+	// Stack is the call stack that created the child, with frames from the
+	// context and fakectx packages trimmed away.
+	Stack string
+
+	// Released reports whether the child was cancelled or hit its deadline.
+	// A child that hits its deadline is marked released by the context
+	// package's timer goroutine just after its Done channel closes, so
+	// Released can briefly lag behind Done.
+	Released bool
+}
+
+// Tree is a root context.Context that records every child derived from it
+// with context.WithCancel, context.WithTimeout, or context.WithDeadline. Use it
+// to catch code that derives a context and never calls its cancel function.
+//
+// Tracking relies on the AfterFunc hook the context package uses when a
+// parent is not one of its own types, so only direct children are recorded.
+// Contexts derived from a child, or from a context.WithValue wrapper around
+// the Tree, are not visible.
+//
+// A Tree is never cancelled and carries no deadline or values.
+type Tree struct {
+	mu       sync.Mutex
+	done     chan struct{}
+	children []*Child
+}
+
+// NewTree returns an empty Tree ready to be passed to code under test.
+func NewTree() *Tree {
+	return &Tree{done: make(chan struct{})}
+}
+
+// TrackLeaks returns a new Tree and registers a cleanup on t that calls
+// RequireNoLeakedChildren when the test finishes.
+func TrackLeaks(t testing.TB) *Tree {
+	t.Helper()
+
+	tree := NewTree()
+	t.Cleanup(func() {
+		tree.RequireNoLeakedChildren(t)
+	})
+
+	return tree
+}
+
+// Deadline returns no deadline; a Tree never expires.
+func (tr *Tree) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns a channel that is never closed.
+func (tr *Tree) Done() <-chan struct{} {
+	return tr.done
+}
+
+// Err always returns nil.
+func (tr *Tree) Err() error {
+	return nil
+}
+
+// Value always returns nil.
+func (tr *Tree) Value(any) any {
+	return nil
+}
+
+// String identifies the Tree when a derived context is printed.
+func (tr *Tree) String() string {
+	return "fakectx.Tree"
+}
+
+// AfterFunc records a new child. The context package calls it when a context
+// is derived from the Tree, and calls the returned stop function when that
+// child is cancelled or reaches its deadline. Since a Tree is never done, f is
+// never run.
+func (tr *Tree) AfterFunc(func()) func() bool {
+	tr.mu.Lock()
+	c := &Child{ID: len(tr.children) + 1, Stack: callerStack()}
+	tr.children = append(tr.children, c)
+	tr.mu.Unlock()
+
+	return func() bool {
+		tr.mu.Lock()
+		defer tr.mu.Unlock()
+
+		if c.Released {
+			return false
+		}
+		c.Released = true
+
+		return true
+	}
+}
+
+// Children returns a snapshot of every child derived from the Tree so far, in
+// the order they were created.
+func (tr *Tree) Children() []Child {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	children := make([]Child, 0, len(tr.children))
+	for _, c := range tr.children {
+		children = append(children, *c)
+	}
+
+	return children
+}
+
+// Leaked returns the children that have not been cancelled or reached their
+// deadline. Calling a cancel function releases its child before returning,
+// but a child that reaches its deadline is released just after its Done
+// channel closes, so a snapshot taken right after <-ctx.Done() may still list
+// it. RequireNoLeakedChildren allows for this.
+func (tr *Tree) Leaked() []Child {
+	var leaked []Child
+	for _, c := range tr.Children() {
+		if !c.Released {
+			leaked = append(leaked, c)
+		}
+	}
+
+	return leaked
+}
+
+// RequireNoLeakedChildren fails the test if any child of the Tree is still
+// live, printing the stack where each leaked child was created. Children that
+// are live at first get up to releaseGrace to be released, so one whose
+// deadline has just passed is not reported.
+func (tr *Tree) RequireNoLeakedChildren(tb testing.TB) {
+	tb.Helper()
+	if msg := tr.leakFailure(); msg != "" {
+		tb.Fatal(msg)
+	}
+}
+
+// leakFailure returns the message RequireNoLeakedChildren fails with, or ""
+// once no child is live.
+func (tr *Tree) leakFailure() string {
+	leaked := tr.Leaked()
+	for deadline := time.Now().Add(releaseGrace); len(leaked) > 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+		leaked = tr.Leaked()
+	}
+	if len(leaked) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "fakectx: %d derived context(s) never cancelled", len(leaked))
+	for _, c := range leaked {
+		fmt.Fprintf(&b, "\n\nchild #%d created at:\n%s", c.ID, c.Stack)
+	}
+	return b.String()
+}
+
+// callerStack formats the current call stack, skipping frames that belong to
+// the context package or to fakectx itself so the first frame is the caller
+// that derived the child.
+func callerStack() string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, "context.") &&
+			!strings.HasPrefix(f.Function, "github.com/madflojo/testlazy/fakes/fakectx.(*Tree)") {
+			fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+		}
+		if !more {
+			break
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
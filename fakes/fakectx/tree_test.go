@@ -0,0 +1,169 @@
+package fakectx
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BehavesAsBackground", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		if _, ok := tree.Deadline(); ok {
+			t.Fatal("expected no deadline")
+		}
+		if err := tree.Err(); err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+		if v := tree.Value("key"); v != nil {
+			t.Fatalf("expected nil value, got %v", v)
+		}
+		select {
+		case <-tree.Done():
+			t.Fatal("Done channel should never close")
+		default:
+		}
+	})
+
+	t.Run("CancelledChildNotLeaked", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		_, cancel := context.WithCancel(tree)
+		cancel()
+
+		if n := len(tree.Children()); n != 1 {
+			t.Fatalf("expected 1 child, got %d", n)
+		}
+		if leaked := tree.Leaked(); len(leaked) != 0 {
+			t.Fatalf("expected no leaked children, got %d", len(leaked))
+		}
+	})
+
+	t.Run("UncancelledChildLeaked", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		_, cancel := context.WithTimeout(tree, time.Hour)
+		leaked := tree.Leaked()
+		cancel()
+
+		if len(leaked) != 1 {
+			t.Fatalf("expected 1 leaked child, got %d", len(leaked))
+		}
+		if leaked[0].ID != 1 {
+			t.Fatalf("expected child ID 1, got %d", leaked[0].ID)
+		}
+		if !strings.Contains(leaked[0].Stack, "TestTree") {
+			t.Fatalf("expected stack to reference the test, got:\n%s", leaked[0].Stack)
+		}
+		if strings.Contains(leaked[0].Stack, "context.WithTimeout") {
+			t.Fatalf("expected context frames to be trimmed, got:\n%s", leaked[0].Stack)
+		}
+		if leaked := tree.Leaked(); len(leaked) != 0 {
+			t.Fatalf("expected no leaked children after cancel, got %d", len(leaked))
+		}
+	})
+
+	t.Run("ExpiredChildNotLeaked", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		ctx, cancel := context.WithTimeout(tree, time.Millisecond)
+		defer cancel()
+
+		<-ctx.Done()
+		// The timer goroutine releases the child just after closing Done.
+		leaked := tree.Leaked()
+		for start := time.Now(); len(leaked) != 0 && time.Since(start) < time.Second; {
+			time.Sleep(time.Millisecond)
+			leaked = tree.Leaked()
+		}
+		if len(leaked) != 0 {
+			t.Fatalf("expected no leaked children after deadline, got %d", len(leaked))
+		}
+	})
+
+	t.Run("ExpiredChildPassesRequire", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		ctx, cancel := context.WithTimeout(tree, time.Millisecond)
+		defer cancel()
+
+		<-ctx.Done()
+		tree.RequireNoLeakedChildren(t)
+	})
+
+	t.Run("ChildrenInCreationOrder", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		_, cancel1 := context.WithCancel(tree)
+		_, cancel2 := context.WithCancel(tree)
+		cancel1()
+
+		children := tree.Children()
+		cancel2()
+
+		if len(children) != 2 {
+			t.Fatalf("expected 2 children, got %d", len(children))
+		}
+		if children[0].ID != 1 || !children[0].Released {
+			t.Fatalf("expected first child released, got %+v", children[0])
+		}
+		if children[1].ID != 2 || children[1].Released {
+			t.Fatalf("expected second child live, got %+v", children[1])
+		}
+	})
+}
+
+func TestRequireNoLeakedChildren(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Passes", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		_, cancel := context.WithCancel(tree)
+		cancel()
+
+		tree.RequireNoLeakedChildren(t)
+	})
+
+	t.Run("FailsWithStack", func(t *testing.T) {
+		t.Parallel()
+
+		tree := NewTree()
+		_, cancel := context.WithCancel(tree)
+		defer cancel()
+
+		msg := tree.leakFailure()
+		if msg == "" {
+			t.Fatal("expected failure for leaked child")
+		}
+		if !strings.Contains(msg, "1 derived context(s) never cancelled") {
+			t.Fatalf("unexpected message: %q", msg)
+		}
+		if !strings.Contains(msg, "child #1 created at:") {
+			t.Fatalf("expected stack in message, got %q", msg)
+		}
+	})
+}
+
+func TestTrackLeaks(t *testing.T) {
+	t.Parallel()
+
+	tree := TrackLeaks(t)
+	ctx, cancel := context.WithCancel(tree)
+	defer cancel()
+
+	if ctx.Err() != nil {
+		t.Fatalf("expected live child, got %v", ctx.Err())
+	}
+}
@@ -9,7 +9,16 @@ Pick only the packages you need and focus on your test logic, not the plumbing.
 Current modules:
   - things/testurl: Pre-built URL helpers for every HTTP scenario.
   - fakes/fakectx: Easy-to-use cancelled or timed-out contexts.
-  - validators: Common checkers for HTTP status codes, headers, and more. (coming soon)
+  - validators/httpstatus: Status-code assertions that explain what came back.
+  - validators/headers: Content-type, CORS, security, caching, and cookie header checks.
+  - validators/jsonbody: Structural JSON comparisons with placeholders and path-annotated diffs.
+  - things/testip: Loopback, private, link-local, documentation, and other IP addresses and prefixes.
+  - things/testbytes: Random, seeded, and patterned bytes with readers for large payloads.
+  - things/testtime: Epoch, Y2038, leap days, DST transitions, and other time.Time edge cases.
+  - things/teststrings: Naughty strings, categorized and tagged, with fuzz corpus seeding.
+  - things/testemail: Valid and invalid email addresses, noting what net/mail accepts.
+  - things/testjson: Malformed and adversarial JSON with expected encoding/json errors.
+  - things/testcert: Root and intermediate CAs, valid and broken leaves, and mTLS configs.
 
 # Quick Start
 
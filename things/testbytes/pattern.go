@@ -0,0 +1,120 @@
+package testbytes
+
+import (
+	"io"
+)
+
+// compressibleText is repeated by Compressible. It is plain English so the
+// payload is easy to recognise in a hex dump.
+const compressibleText = "the quick brown fox jumps over the lazy dog. "
+
+// incompressibleSeed is the seed behind Incompressible.
+const incompressibleSeed = 0x7e57b17e5
+
+// Zeros returns n zero bytes.
+func Zeros(n int) []byte {
+	return make([]byte, n)
+}
+
+// ZerosReader returns a reader that yields n zero bytes and then io.EOF.
+func ZerosReader(n int64) io.Reader {
+	return RepeatReader([]byte{0x00}, n)
+}
+
+// Ones returns n bytes of 0xFF, every bit set.
+func Ones(n int) []byte {
+	return Repeat([]byte{0xFF}, n)
+}
+
+// OnesReader returns a reader that yields n bytes of 0xFF and then io.EOF.
+func OnesReader(n int64) io.Reader {
+	return RepeatReader([]byte{0xFF}, n)
+}
+
+// Incrementing returns n bytes counting up from 0x00 and wrapping after 0xFF,
+// so byte i is i%256. An offset or truncation bug shows up as a wrong value.
+func Incrementing(n int) []byte {
+	return Repeat(incrementingPattern(), n)
+}
+
+// IncrementingReader returns a reader that yields the same n bytes as
+// Incrementing and then io.EOF.
+func IncrementingReader(n int64) io.Reader {
+	return RepeatReader(incrementingPattern(), n)
+}
+
+// Repeat returns n bytes made of pattern repeated, with the last copy cut
+// short if needed. It panics if pattern is empty and n is positive.
+func Repeat(pattern []byte, n int) []byte {
+	b := make([]byte, n)
+	if n == 0 {
+		return b
+	}
+	if len(pattern) == 0 {
+		panic("testbytes: Repeat called with an empty pattern")
+	}
+	copy(b, pattern)
+	for filled := len(pattern); filled < n; filled *= 2 {
+		copy(b[filled:], b[:filled])
+	}
+	return b
+}
+
+// RepeatReader returns a reader that yields the same n bytes as Repeat and
+// then io.EOF. The pattern is copied, so later changes to it have no effect.
+// It panics if pattern is empty and n is positive.
+func RepeatReader(pattern []byte, n int64) io.Reader {
+	if n > 0 && len(pattern) == 0 {
+		panic("testbytes: RepeatReader called with an empty pattern")
+	}
+	return io.LimitReader(&patternReader{pattern: append([]byte(nil), pattern...)}, n)
+}
+
+// Compressible returns n bytes of repeated text that any general-purpose
+// compressor shrinks to a small fraction of its size.
+func Compressible(n int) []byte {
+	return Repeat([]byte(compressibleText), n)
+}
+
+// CompressibleReader returns a reader that yields the same n bytes as
+// Compressible and then io.EOF.
+func CompressibleReader(n int64) io.Reader {
+	return RepeatReader([]byte(compressibleText), n)
+}
+
+// Incompressible returns n bytes of seeded pseudo-random data that does not
+// shrink when compressed. Unlike Random, the bytes are the same on every call.
+func Incompressible(n int) []byte {
+	return Seeded(incompressibleSeed, n)
+}
+
+// IncompressibleReader returns a reader that yields the same n bytes as
+// Incompressible and then io.EOF.
+func IncompressibleReader(n int64) io.Reader {
+	return SeededReader(incompressibleSeed, n)
+}
+
+// incrementingPattern returns the bytes 0x00 through 0xFF in order.
+func incrementingPattern() []byte {
+	p := make([]byte, 256)
+	for i := range p {
+		p[i] = byte(i)
+	}
+	return p
+}
+
+// patternReader yields pattern repeated forever.
+type patternReader struct {
+	pattern []byte
+	off     int
+}
+
+func (p *patternReader) Read(b []byte) (int, error) {
+	n := 0
+	for n < len(b) {
+		c := copy(b[n:], p.pattern[p.off:])
+		n += c
+		p.off = (p.off + c) % len(p.pattern)
+	}
+	return n, nil
+}
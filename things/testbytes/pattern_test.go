@@ -0,0 +1,97 @@
+package testbytes
+
+import (
+	"bytes"
+	"compress/flate"
+	"testing"
+)
+
+func TestPatterns(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		got  []byte
+		want func(i int) byte
+	}{
+		{"Zeros", Zeros(300), func(int) byte { return 0x00 }},
+		{"Ones", Ones(300), func(int) byte { return 0xFF }},
+		{"Incrementing", Incrementing(300), func(i int) byte { return byte(i % 256) }},
+		{"Repeat", Repeat([]byte("abc"), 300), func(i int) byte { return "abc"[i%3] }},
+		{"Repeat single copy", Repeat([]byte("abcdef"), 4), func(i int) byte { return "abcd"[i] }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			for i, b := range tc.got {
+				if b != tc.want(i) {
+					t.Fatalf("Expected byte %d to be %#x, got %#x", i, tc.want(i), b)
+				}
+			}
+		})
+	}
+}
+
+func TestRepeatEmptyPattern(t *testing.T) {
+	t.Parallel()
+	if got := Repeat(nil, 0); len(got) != 0 {
+		t.Errorf("Expected an empty result, got %d bytes", len(got))
+	}
+
+	for name, fn := range map[string]func(){
+		"Repeat":       func() { Repeat(nil, 1) },
+		"RepeatReader": func() { RepeatReader(nil, 1) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected a panic for an empty pattern")
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestRepeatReaderCopiesPattern(t *testing.T) {
+	t.Parallel()
+	pattern := []byte("ab")
+	r := RepeatReader(pattern, 4)
+	pattern[0] = 'x'
+	got := make([]byte, 4)
+	_, _ = r.Read(got)
+	if string(got) != "abab" {
+		t.Errorf("Expected %q, got %q", "abab", got)
+	}
+}
+
+func TestCompression(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		data  []byte
+		check func(ratio float64) bool
+	}{
+		{"Compressible", Compressible(Size64KiB), func(r float64) bool { return r < 0.05 }},
+		{"Incompressible", Incompressible(Size64KiB), func(r float64) bool { return r > 0.99 }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			w, _ := flate.NewWriter(&buf, flate.BestCompression)
+			_, _ = w.Write(tc.data)
+			_ = w.Close()
+			ratio := float64(buf.Len()) / float64(len(tc.data))
+			if !tc.check(ratio) {
+				t.Errorf("Unexpected compression ratio %.3f", ratio)
+			}
+		})
+	}
+
+	if !bytes.Equal(Incompressible(100), Incompressible(100)) {
+		t.Error("Expected Incompressible to be deterministic")
+	}
+}
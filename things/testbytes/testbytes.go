@@ -0,0 +1,91 @@
+/*
+Package testbytes provides byte payloads for tests: random and seeded data,
+patterned fills, sizes around common buffer limits, invalid UTF-8, and data
+that does or does not compress.
+
+	github.com/madflojo/testlazy/things/testbytes
+
+Every payload comes as a fresh []byte, and most also as an io.Reader that
+streams the same bytes without holding them in memory, so a test can push a
+gigabyte through a handler as cheaply as a kilobyte.
+
+Example usage
+
+	body := testbytes.Seeded(42, testbytes.Size64KiB+1)
+
+	for _, n := range testbytes.BoundarySizes() {
+	    req := httptest.NewRequest(http.MethodPost, "/", testbytes.ZerosReader(int64(n)))
+	    // ...
+	}
+
+Seeded data depends only on the seed, so a failing case can be reproduced
+from the seed alone.
+*/
+package testbytes
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	mathrand "math/rand/v2"
+)
+
+// Sizes on either side of common buffer limits.
+const (
+	// Size4KiB is a typical page and bufio buffer size.
+	Size4KiB = 4 << 10
+
+	// Size64KiB is a typical socket and pipe buffer size.
+	Size64KiB = 64 << 10
+
+	// Size1MiB is a common default for request body limits.
+	Size1MiB = 1 << 20
+)
+
+// BoundarySizes returns Size4KiB and Size64KiB with their neighbours one byte
+// either side, followed by Size1MiB, in ascending order.
+func BoundarySizes() []int {
+	return []int{
+		Size4KiB - 1, Size4KiB, Size4KiB + 1,
+		Size64KiB - 1, Size64KiB, Size64KiB + 1,
+		Size1MiB,
+	}
+}
+
+// Random returns n bytes from crypto/rand. Use Seeded when a failure must be
+// reproducible.
+func Random(n int) []byte {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return b
+}
+
+// RandomReader returns a reader that yields n bytes from crypto/rand and then
+// io.EOF.
+func RandomReader(n int64) io.Reader {
+	return io.LimitReader(rand.Reader, n)
+}
+
+// Seeded returns n pseudo-random bytes determined by seed. The same seed
+// always yields the same bytes, and a longer payload starts with the bytes of
+// a shorter one.
+func Seeded(seed uint64, n int) []byte {
+	b := make([]byte, n)
+	_, _ = seededSource(seed).Read(b)
+	return b
+}
+
+// SeededReader returns a reader that yields the same n bytes as Seeded and
+// then io.EOF.
+func SeededReader(seed uint64, n int64) io.Reader {
+	return io.LimitReader(seededSource(seed), n)
+}
+
+// seededSource returns a ChaCha8 generator keyed by seed. ChaCha8 reads are
+// independent of how the output is split into calls, which keeps Seeded and
+// SeededReader in step.
+func seededSource(seed uint64) *mathrand.ChaCha8 {
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:], seed)
+	return mathrand.NewChaCha8(key)
+}
@@ -0,0 +1,42 @@
+package testbytes
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+func ExampleSeeded() {
+	fmt.Println(len(Seeded(42, Size4KiB+1)))
+	// Output: 4097
+}
+
+func ExampleIncrementing() {
+	fmt.Printf("% x\n", Incrementing(4))
+	// Output: 00 01 02 03
+}
+
+func ExampleRepeat() {
+	fmt.Printf("%s\n", Repeat([]byte("ab"), 5))
+	// Output: ababa
+}
+
+func ExampleZerosReader() {
+	n, _ := io.Copy(io.Discard, ZerosReader(Size1MiB))
+	fmt.Println(n)
+	// Output: 1048576
+}
+
+func ExampleBoundarySizes() {
+	fmt.Println(BoundarySizes())
+	// Output: [4095 4096 4097 65535 65536 65537 1048576]
+}
+
+func ExampleInvalidUTF8() {
+	for _, s := range InvalidUTF8()[:2] {
+		fmt.Println(s.Name, "->", utf8.Valid(s.Data))
+	}
+	// Output:
+	// lone continuation byte -> false
+	// truncated two-byte -> false
+}
@@ -0,0 +1,114 @@
+package testbytes
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestBoundarySizes(t *testing.T) {
+	t.Parallel()
+	want := []int{4095, 4096, 4097, 65535, 65536, 65537, 1048576}
+	got := BoundarySizes()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d sizes, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected size %d to be %d, got %d", i, want[i], got[i])
+		}
+	}
+}
+
+func TestRandom(t *testing.T) {
+	t.Parallel()
+	a, b := Random(64), Random(64)
+	if len(a) != 64 || len(b) != 64 {
+		t.Fatalf("Expected 64 bytes, got %d and %d", len(a), len(b))
+	}
+	if bytes.Equal(a, b) {
+		t.Error("Expected two Random calls to differ")
+	}
+	if len(Random(0)) != 0 {
+		t.Error("Expected Random(0) to be empty")
+	}
+
+	n, err := io.Copy(io.Discard, RandomReader(Size64KiB+1))
+	if err != nil || n != Size64KiB+1 {
+		t.Errorf("Expected %d bytes from RandomReader, got %d (%v)", Size64KiB+1, n, err)
+	}
+}
+
+func TestSeeded(t *testing.T) {
+	t.Parallel()
+	a := Seeded(42, 1000)
+	if !bytes.Equal(a, Seeded(42, 1000)) {
+		t.Error("Expected the same seed to yield the same bytes")
+	}
+	if bytes.Equal(a, Seeded(43, 1000)) {
+		t.Error("Expected different seeds to yield different bytes")
+	}
+	if !bytes.Equal(Seeded(42, 10), a[:10]) {
+		t.Error("Expected a shorter payload to be a prefix of a longer one")
+	}
+}
+
+// TestReaders checks every reader against its slice counterpart, including
+// under one-byte and odd-sized reads.
+func TestReaders(t *testing.T) {
+	t.Parallel()
+	const n = Size4KiB + 1
+	tests := []struct {
+		name   string
+		reader func(int64) io.Reader
+		want   []byte
+	}{
+		{"Seeded", func(n int64) io.Reader { return SeededReader(7, n) }, Seeded(7, n)},
+		{"Zeros", ZerosReader, Zeros(n)},
+		{"Ones", OnesReader, Ones(n)},
+		{"Incrementing", IncrementingReader, Incrementing(n)},
+		{
+			"Repeat",
+			func(n int64) io.Reader { return RepeatReader([]byte("abc"), n) },
+			Repeat([]byte("abc"), n),
+		},
+		{"Compressible", CompressibleReader, Compressible(n)},
+		{"Incompressible", IncompressibleReader, Incompressible(n)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if len(tc.want) != n {
+				t.Fatalf("Expected %d bytes, got %d", n, len(tc.want))
+			}
+			if err := iotest.TestReader(tc.reader(n), tc.want); err != nil {
+				t.Errorf("Expected reader to match slice: %v", err)
+			}
+			got, err := io.ReadAll(iotest.OneByteReader(tc.reader(n)))
+			if err != nil || !bytes.Equal(got, tc.want) {
+				t.Errorf("Expected one-byte reads to match slice, got %d bytes (%v)", len(got), err)
+			}
+			if got, _ := io.ReadAll(tc.reader(0)); len(got) != 0 {
+				t.Errorf("Expected an empty reader, got %d bytes", len(got))
+			}
+		})
+	}
+}
+
+func TestReaderStreamsLargeSizes(t *testing.T) {
+	t.Parallel()
+	// Allocating a terabyte up front would fail, so reading the start of
+	// each reader shows the size is never materialized.
+	const n = 1 << 40
+	for name, r := range map[string]io.Reader{
+		"Zeros":          ZerosReader(n),
+		"Seeded":         SeededReader(1, n),
+		"Incompressible": IncompressibleReader(n),
+	} {
+		if got, err := io.CopyN(io.Discard, r, Size1MiB); err != nil || got != Size1MiB {
+			t.Errorf("Expected %s to stream %d bytes, got %d (%v)", name, Size1MiB, got, err)
+		}
+	}
+}
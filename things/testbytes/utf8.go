@@ -0,0 +1,45 @@
+package testbytes
+
+// Sequence is a named byte sequence.
+type Sequence struct {
+	// Name briefly describes what is wrong with Data.
+	Name string
+
+	// Data holds the bytes.
+	Data []byte
+}
+
+// InvalidUTF8 returns a fresh copy of the invalid UTF-8 corpus. Every entry
+// fails utf8.Valid:
+//
+//	lone continuation byte    "\x80"
+//	truncated two-byte        "\xc3"
+//	truncated three-byte      "\xe2\x82"
+//	truncated four-byte       "\xf0\x9f\x98"
+//	overlong slash            "\xc0\xaf"
+//	overlong null             "\xc0\x80"
+//	UTF-16 surrogate          "\xed\xa0\x80"
+//	above U+10FFFF            "\xf4\x90\x80\x80"
+//	invalid byte 0xFE         "\xfe"
+//	invalid byte 0xFF         "\xff"
+//	invalid in the middle     "abc\xffdef"
+//	valid then truncated      "héllo\xe2\x82"
+//
+// Overlong encodings and surrogates are the classic way past filters that
+// check bytes before decoding them.
+func InvalidUTF8() []Sequence {
+	return []Sequence{
+		{Name: "lone continuation byte", Data: []byte("\x80")},
+		{Name: "truncated two-byte", Data: []byte("\xc3")},
+		{Name: "truncated three-byte", Data: []byte("\xe2\x82")},
+		{Name: "truncated four-byte", Data: []byte("\xf0\x9f\x98")},
+		{Name: "overlong slash", Data: []byte("\xc0\xaf")},
+		{Name: "overlong null", Data: []byte("\xc0\x80")},
+		{Name: "UTF-16 surrogate", Data: []byte("\xed\xa0\x80")},
+		{Name: "above U+10FFFF", Data: []byte("\xf4\x90\x80\x80")},
+		{Name: "invalid byte 0xFE", Data: []byte("\xfe")},
+		{Name: "invalid byte 0xFF", Data: []byte("\xff")},
+		{Name: "invalid in the middle", Data: []byte("abc\xffdef")},
+		{Name: "valid then truncated", Data: []byte("héllo\xe2\x82")},
+	}
+}
@@ -0,0 +1,34 @@
+package testbytes
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestInvalidUTF8(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[string]bool)
+	for _, tc := range InvalidUTF8() {
+		if seen[tc.Name] {
+			t.Fatalf("Duplicate entry name %q", tc.Name)
+		}
+		seen[tc.Name] = true
+
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if utf8.Valid(tc.Data) {
+				t.Errorf("Expected %q to be invalid UTF-8", tc.Data)
+			}
+		})
+	}
+}
+
+func TestInvalidUTF8ReturnsCopy(t *testing.T) {
+	t.Parallel()
+	first := InvalidUTF8()
+	first[0].Data[0] = 'a'
+	if InvalidUTF8()[0].Data[0] == 'a' {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
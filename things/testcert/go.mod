@@ -0,0 +1,3 @@
+module github.com/madflojo/testlazy/things/testcert
+
+go 1.24.3
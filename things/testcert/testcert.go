@@ -0,0 +1,313 @@
+/*
+Package testcert generates TLS certificates and certificate authorities at
+test time, so there are no checked-in PEM files to expire.
+
+	github.com/madflojo/testlazy/things/testcert
+
+New creates a root CA and an intermediate CA. The Authority then issues a
+valid server leaf for localhost, example.com, 127.0.0.1, and ::1, which
+covers httptest servers and the https hosts in testurl, along with leaves
+that are broken on purpose and client certificates for mutual TLS. Every
+leaf is signed by the intermediate and carries it in its chain.
+
+Example usage
+
+	ca := testcert.New(t)
+
+	srv := httptest.NewUnstartedServer(handler)
+	srv.TLS = ca.ServerConfig(ca.Expired(t))
+	srv.StartTLS()
+	defer srv.Close()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: ca.ClientConfig()}}
+	_, err := client.Get(srv.URL) // fails: the certificate has expired
+
+Keys are ECDSA P-256, which keeps generation fast enough to run per test.
+*/
+package testcert
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+const (
+	// LocalhostName is a DNS name in the valid server leaf.
+	LocalhostName = "localhost"
+
+	// ExampleName is a DNS name in the valid server leaf, matching the host
+	// of the https URLs in testurl.
+	ExampleName = "example.com"
+
+	// WrongHostName is the only DNS name in the leaf returned by WrongHost.
+	WrongHostName = "wrong-host.invalid"
+
+	// ClientName is the common name of client certificates.
+	ClientName = "testlazy client"
+)
+
+// Validity is how long before and after the time of issue a valid
+// certificate is good for.
+const Validity = 24 * time.Hour
+
+// Cert is a certificate with its private key and the intermediate
+// certificates that link it to its root.
+type Cert struct {
+	// Certificate is the parsed certificate.
+	Certificate *x509.Certificate
+
+	// Key is the private key for Certificate.
+	Key *ecdsa.PrivateKey
+
+	// Chain holds the intermediate certificates, nearest first. It is empty
+	// for roots and self-signed certificates.
+	Chain []*x509.Certificate
+}
+
+// TLSCertificate returns c and its chain as a tls.Certificate.
+func (c *Cert) TLSCertificate() tls.Certificate {
+	der := [][]byte{c.Certificate.Raw}
+	for _, ic := range c.Chain {
+		der = append(der, ic.Raw)
+	}
+	return tls.Certificate{Certificate: der, PrivateKey: c.Key, Leaf: c.Certificate}
+}
+
+// CertPEM returns c followed by its chain, PEM-encoded.
+func (c *Cert) CertPEM() []byte {
+	out := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Certificate.Raw})
+	for _, ic := range c.Chain {
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ic.Raw})...)
+	}
+	return out
+}
+
+// KeyPEM returns the private key of c, PEM-encoded in PKCS #8 form. It
+// fails the test if the key cannot be encoded.
+func (c *Cert) KeyPEM(t testing.TB) []byte {
+	t.Helper()
+	out, err := c.keyPEM()
+	if err != nil {
+		t.Fatalf("testcert: failed to encode key: %v", err)
+		return nil
+	}
+	return out
+}
+
+// keyPEM is KeyPEM without the test, returning the encoding error instead.
+func (c *Cert) keyPEM() ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(c.Key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// Authority is a root CA with an intermediate CA that issues leaves.
+type Authority struct {
+	// Root is the self-signed root CA.
+	Root *Cert
+
+	// Intermediate is the CA that signs every leaf, issued by Root.
+	Intermediate *Cert
+}
+
+// New returns an Authority with a freshly generated root and intermediate
+// CA.
+func New(t testing.TB) *Authority {
+	t.Helper()
+
+	now := time.Now()
+	root := issue(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "testlazy root CA"},
+		NotBefore:             now.Add(-Validity),
+		NotAfter:              now.Add(Validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil)
+	intermediate := issue(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "testlazy intermediate CA"},
+		NotBefore:             now.Add(-Validity),
+		NotAfter:              now.Add(Validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}, root)
+
+	return &Authority{Root: root, Intermediate: intermediate}
+}
+
+// Pool returns a certificate pool holding only the root CA.
+func (a *Authority) Pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(a.Root.Certificate)
+	return pool
+}
+
+// Server returns a valid server leaf for LocalhostName, ExampleName,
+// 127.0.0.1, and ::1.
+func (a *Authority) Server(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	return issue(t, serverTemplate(now.Add(-Validity), now.Add(Validity)), a.Intermediate)
+}
+
+// Expired returns a server leaf like Server that expired a day ago.
+func (a *Authority) Expired(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	return issue(t, serverTemplate(now.Add(-2*Validity), now.Add(-Validity)), a.Intermediate)
+}
+
+// NotYetValid returns a server leaf like Server that becomes valid in a
+// day.
+func (a *Authority) NotYetValid(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	return issue(t, serverTemplate(now.Add(Validity), now.Add(2*Validity)), a.Intermediate)
+}
+
+// WrongHost returns a valid server leaf for WrongHostName only, so clients
+// connecting to any of the hosts in Server reject it.
+func (a *Authority) WrongHost(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	tmpl := serverTemplate(now.Add(-Validity), now.Add(Validity))
+	tmpl.Subject.CommonName = WrongHostName
+	tmpl.DNSNames = []string{WrongHostName}
+	tmpl.IPAddresses = nil
+	return issue(t, tmpl, a.Intermediate)
+}
+
+// WrongKeyUsage returns a leaf like Server whose extended key usage allows
+// client authentication only, so clients reject it as a server
+// certificate.
+func (a *Authority) WrongKeyUsage(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	tmpl := serverTemplate(now.Add(-Validity), now.Add(Validity))
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	return issue(t, tmpl, a.Intermediate)
+}
+
+// Client returns a valid client certificate for mutual TLS, with the
+// common name ClientName.
+func (a *Authority) Client(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	return issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: ClientName},
+		NotBefore:   now.Add(-Validity),
+		NotAfter:    now.Add(Validity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, a.Intermediate)
+}
+
+// SelfSigned returns a server leaf for the same hosts as Authority.Server
+// that signs itself, so no Authority pool trusts it.
+func SelfSigned(t testing.TB) *Cert {
+	t.Helper()
+	now := time.Now()
+	return issue(t, serverTemplate(now.Add(-Validity), now.Add(Validity)), nil)
+}
+
+// ServerConfig returns a server-side tls.Config that presents leaf and
+// verifies any client certificate against the root CA. Use MTLSServerConfig
+// to require one.
+func (a *Authority) ServerConfig(leaf *Cert) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{leaf.TLSCertificate()},
+		ClientCAs:    a.Pool(),
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// MTLSServerConfig returns a server-side tls.Config like ServerConfig that
+// rejects clients without a certificate issued by the Authority.
+func (a *Authority) MTLSServerConfig(leaf *Cert) *tls.Config {
+	cfg := a.ServerConfig(leaf)
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg
+}
+
+// ClientConfig returns a client-side tls.Config that trusts the root CA and
+// presents clients, such as a certificate from Client, when the server asks
+// for one.
+func (a *Authority) ClientConfig(clients ...*Cert) *tls.Config {
+	cfg := &tls.Config{
+		RootCAs:    a.Pool(),
+		MinVersion: tls.VersionTLS12,
+	}
+	for _, c := range clients {
+		cfg.Certificates = append(cfg.Certificates, c.TLSCertificate())
+	}
+	return cfg
+}
+
+// serverTemplate returns a server leaf template for the default hosts, valid
+// from notBefore to notAfter.
+func serverTemplate(notBefore, notAfter time.Time) *x509.Certificate {
+	return &x509.Certificate{
+		Subject:     pkix.Name{CommonName: LocalhostName},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:    []string{LocalhostName, ExampleName},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+}
+
+// issue generates a key for tmpl and signs it with parent, or with the new
+// key itself when parent is nil.
+func issue(t testing.TB, tmpl *x509.Certificate, parent *Cert) *Cert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("testcert: failed to generate key: %v", err)
+		return nil
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatalf("testcert: failed to generate serial number: %v", err)
+		return nil
+	}
+	tmpl.SerialNumber = serial
+
+	signer, signerKey := tmpl, key
+	var chain []*x509.Certificate
+	if parent != nil {
+		signer, signerKey = parent.Certificate, parent.Key
+		// Roots stay out of the chain; clients already hold them.
+		if !bytes.Equal(parent.Certificate.RawIssuer, parent.Certificate.RawSubject) {
+			chain = append([]*x509.Certificate{parent.Certificate}, parent.Chain...)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("testcert: failed to create certificate: %v", err)
+		return nil
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("testcert: failed to parse certificate: %v", err)
+		return nil
+	}
+	return &Cert{Certificate: cert, Key: key, Chain: chain}
+}
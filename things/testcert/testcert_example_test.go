@@ -0,0 +1,77 @@
+package testcert
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ExampleAuthority_MTLSServerConfig runs a mutual TLS server and a client
+// that presents a certificate from the same Authority.
+func ExampleAuthority_MTLSServerConfig() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+	ca := New(t)
+
+	srv := httptest.NewUnstartedServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, r.TLS.PeerCertificates[0].Subject.CommonName)
+		}),
+	)
+	srv.TLS = ca.MTLSServerConfig(ca.Server(t))
+	srv.StartTLS()
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: ca.ClientConfig(ca.Client(t))},
+	}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer res.Body.Close()
+
+	fmt.Println(res.StatusCode)
+	// Output: 200
+}
+
+// ExampleAuthority_Expired verifies a broken leaf against the Authority's
+// pool to show why clients reject it.
+func ExampleAuthority_Expired() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+	ca := New(t)
+	leaf := ca.Expired(t)
+
+	intermediates := x509.NewCertPool()
+	for _, ic := range leaf.Chain {
+		intermediates.AddCert(ic)
+	}
+	_, err := leaf.Certificate.Verify(x509.VerifyOptions{
+		DNSName:       LocalhostName,
+		Roots:         ca.Pool(),
+		Intermediates: intermediates,
+	})
+	var invalid x509.CertificateInvalidError
+	fmt.Println(errors.As(err, &invalid) && invalid.Reason == x509.Expired)
+	// Output: true
+}
+
+// ExampleCert_KeyPEM writes a leaf and its key as PEM, as a server that
+// loads its certificate from files would read them.
+func ExampleCert_KeyPEM() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+	leaf := New(t).Server(t)
+
+	pair, err := tls.X509KeyPair(leaf.CertPEM(), leaf.KeyPEM(t))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(len(pair.Certificate))
+	// Output: 2
+}
@@ -0,0 +1,189 @@
+package testcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// serve starts a TLS server with cfg and returns its URL.
+func serve(t *testing.T, cfg *tls.Config) string {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			name := "none"
+			if len(r.TLS.PeerCertificates) > 0 {
+				name = r.TLS.PeerCertificates[0].Subject.CommonName
+			}
+			_, _ = io.WriteString(w, name)
+		}),
+	)
+	srv.TLS = cfg
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// get fetches url with a client using cfg and returns the body.
+func get(t *testing.T, cfg *tls.Config, url string) (string, error) {
+	t.Helper()
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+	t.Cleanup(client.CloseIdleConnections)
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+func TestServerLeaves(t *testing.T) {
+	t.Parallel()
+	ca := New(t)
+
+	tests := []struct {
+		name  string
+		leaf  func(testing.TB) *Cert
+		check func(error) bool
+	}{
+		{"Valid", ca.Server, func(err error) bool { return err == nil }},
+		{"Expired", ca.Expired, invalidBecause(x509.Expired)},
+		{"NotYetValid", ca.NotYetValid, invalidBecause(x509.Expired)},
+		{"WrongKeyUsage", ca.WrongKeyUsage, invalidBecause(x509.IncompatibleUsage)},
+		{"WrongHost", ca.WrongHost, func(err error) bool {
+			var target x509.HostnameError
+			return errors.As(err, &target)
+		}},
+		{"SelfSigned", SelfSigned, func(err error) bool {
+			var target x509.UnknownAuthorityError
+			return errors.As(err, &target)
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			url := serve(t, ca.ServerConfig(tc.leaf(t)))
+			if _, err := get(t, ca.ClientConfig(), url); !tc.check(err) {
+				t.Errorf("Unexpected handshake result: %v", err)
+			}
+		})
+	}
+}
+
+func TestServerHosts(t *testing.T) {
+	t.Parallel()
+	ca := New(t)
+	leaf := ca.Server(t)
+
+	for _, host := range []string{LocalhostName, ExampleName, "127.0.0.1", "::1"} {
+		if err := leaf.Certificate.VerifyHostname(host); err != nil {
+			t.Errorf("Expected the leaf to cover %q: %v", host, err)
+		}
+	}
+	if err := ca.WrongHost(t).Certificate.VerifyHostname(LocalhostName); err == nil {
+		t.Error("Expected WrongHost not to cover localhost")
+	}
+
+	// The chain links the leaf to the root through the intermediate.
+	opts := x509.VerifyOptions{Roots: ca.Pool(), Intermediates: x509.NewCertPool()}
+	for _, ic := range leaf.Chain {
+		opts.Intermediates.AddCert(ic)
+	}
+	chains, err := leaf.Certificate.Verify(opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := len(chains[0]); got != 3 {
+		t.Errorf("Expected a chain of 3, got %d", got)
+	}
+	if len(ca.Intermediate.Chain) != 0 || len(ca.Root.Chain) != 0 || len(SelfSigned(t).Chain) != 0 {
+		t.Error("Expected roots to stay out of chains")
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	t.Parallel()
+	ca := New(t)
+	other := New(t)
+
+	tests := []struct {
+		name    string
+		clients []*Cert
+		want    string
+		fails   bool
+	}{
+		{"Client certificate", []*Cert{ca.Client(t)}, ClientName, false},
+		{"No client certificate", nil, "", true},
+		{"Server certificate as client", []*Cert{ca.Server(t)}, "", true},
+		{"Client from another authority", []*Cert{other.Client(t)}, "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			url := serve(t, ca.MTLSServerConfig(ca.Server(t)))
+			got, err := get(t, ca.ClientConfig(tc.clients...), url)
+			if (err != nil) != tc.fails {
+				t.Fatalf("Expected failure to be %t, got %v", tc.fails, err)
+			}
+			if got != tc.want {
+				t.Errorf("Expected peer %q, got %q", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("Optional client certificate", func(t *testing.T) {
+		t.Parallel()
+		url := serve(t, ca.ServerConfig(ca.Server(t)))
+		if got, err := get(t, ca.ClientConfig(), url); err != nil || got != "none" {
+			t.Errorf("Expected an anonymous client to connect, got %q (%v)", got, err)
+		}
+	})
+}
+
+func TestPEM(t *testing.T) {
+	t.Parallel()
+	ca := New(t)
+	leaf := ca.Server(t)
+
+	certPEM := leaf.CertPEM()
+	if n := strings.Count(string(certPEM), "BEGIN CERTIFICATE"); n != 2 {
+		t.Errorf("Expected the leaf and intermediate, got %d certificates", n)
+	}
+	if _, err := tls.X509KeyPair(certPEM, leaf.KeyPEM(t)); err != nil {
+		t.Errorf("Expected a usable key pair: %v", err)
+	}
+
+	// A key without a curve cannot be marshaled.
+	broken := &Cert{Certificate: leaf.Certificate, Key: &ecdsa.PrivateKey{}}
+	if _, err := broken.keyPEM(); err == nil {
+		t.Error("Expected an error encoding a key without a curve")
+	}
+
+	block, _ := pem.Decode(ca.Root.CertPEM())
+	if block == nil || block.Type != "CERTIFICATE" {
+		t.Fatal("Expected a PEM certificate for the root")
+	}
+	root, err := x509.ParseCertificate(block.Bytes)
+	if err != nil || !root.IsCA {
+		t.Errorf("Expected a CA certificate, got %v", err)
+	}
+}
+
+func invalidBecause(reason x509.InvalidReason) func(error) bool {
+	return func(err error) bool {
+		var target x509.CertificateInvalidError
+		return errors.As(err, &target) && target.Reason == reason
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/madflojo/testlazy/things/testemail
+
+go 1.24.3
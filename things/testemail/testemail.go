@@ -0,0 +1,216 @@
+/*
+Package testemail provides valid and invalid email addresses for tests of
+signup forms, notification senders, and anything else that validates them.
+
+	github.com/madflojo/testlazy/things/testemail
+
+Valid addresses follow RFC 5322 and its internationalized extension RFC
+6531, and include plus-addressing, quoted local parts, IP-literal and IDN
+domains, and addresses at the length limits of RFC 5321. Invalid addresses
+break those rules with double dots, a missing @, a trailing dot, overlong
+parts, and the like.
+
+Most Go code validates with net/mail.ParseAddress, which is more lenient
+than the RFCs in places, so every entry records whether it accepts the
+address.
+
+Example usage
+
+	for _, a := range testemail.Invalid() {
+	    t.Run(a.Name, func(t *testing.T) {
+	        if err := signup(a.Address); err == nil {
+	            t.Errorf("signup accepted %q", a.Address)
+	        }
+	    })
+	}
+
+All domains are under names reserved by RFC 2606, so no mail is ever
+delivered anywhere real.
+*/
+package testemail
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// Length limits from RFC 5321.
+const (
+	// MaxLocalLength is the longest local part, the text before the @.
+	MaxLocalLength = 64
+
+	// MaxLabelLength is the longest label in a domain.
+	MaxLabelLength = 63
+
+	// MaxLength is the longest address that fits in an SMTP path.
+	MaxLength = 254
+)
+
+// Address is a single email address of the corpus.
+type Address struct {
+	// Name briefly describes what is special about Address.
+	Name string
+
+	// Address is the raw address string.
+	Address string
+
+	// ParseAddressAccepts reports whether net/mail.ParseAddress returns no
+	// error for Address.
+	ParseAddressAccepts bool
+}
+
+// Valid returns a fresh copy of the valid address corpus. net/mail accepts
+// every entry:
+//
+//	simple                   "user@example.com"
+//	single characters        "a@b.example"
+//	plus-addressing          "user+tag@example.com"
+//	dotted local part        "first.last@example.com"
+//	subdomain                "user@mail.sub.example.com"
+//	all atext specials       "!#$%&'*+-/=?^_`{|}~@example.com"
+//	quoted with space        "\"john doe\"@example.com"
+//	quoted with specials     "\"a@b,c<d>\\\"e\"@example.com"
+//	quoted double dot        "\"john..doe\"@example.com"
+//	IPv4 literal             "user@[192.0.2.1]"
+//	single-label domain      "user@localhost"
+//	IDN domain               "user@bücher.example"
+//	punycode domain          "user@xn--bcher-kva.example"
+//	Unicode local part       "ñoño@example.com"
+//	all Unicode              "用户@例子.example"
+//	longest local part       64 "a"s at example.com
+//	longest label            "a@" and a 63-character label
+//	longest address          254 characters
+//
+// IPv6 literals such as "user@[IPv6:2001:db8::1]" are left out: whether
+// net/mail accepts them depends on the Go version.
+func Valid() []Address {
+	return []Address{
+		{Name: "simple", Address: "user@example.com", ParseAddressAccepts: true},
+		{Name: "single characters", Address: "a@b.example", ParseAddressAccepts: true},
+		{Name: "plus-addressing", Address: "user+tag@example.com", ParseAddressAccepts: true},
+		{Name: "dotted local part", Address: "first.last@example.com", ParseAddressAccepts: true},
+		{Name: "subdomain", Address: "user@mail.sub.example.com", ParseAddressAccepts: true},
+		{
+			Name:                "all atext specials",
+			Address:             "!#$%&'*+-/=?^_`{|}~@example.com",
+			ParseAddressAccepts: true,
+		},
+		{Name: "quoted with space", Address: `"john doe"@example.com`, ParseAddressAccepts: true},
+		{
+			Name:                "quoted with specials",
+			Address:             `"a@b,c<d>\"e"@example.com`,
+			ParseAddressAccepts: true,
+		},
+		{Name: "quoted double dot", Address: `"john..doe"@example.com`, ParseAddressAccepts: true},
+		{Name: "IPv4 literal", Address: "user@[192.0.2.1]", ParseAddressAccepts: true},
+		{Name: "single-label domain", Address: "user@localhost", ParseAddressAccepts: true},
+		{Name: "IDN domain", Address: "user@bücher.example", ParseAddressAccepts: true},
+		{Name: "punycode domain", Address: "user@xn--bcher-kva.example", ParseAddressAccepts: true},
+		{Name: "Unicode local part", Address: "ñoño@example.com", ParseAddressAccepts: true},
+		{Name: "all Unicode", Address: "用户@例子.example", ParseAddressAccepts: true},
+		{
+			Name:                "longest local part",
+			Address:             strings.Repeat("a", MaxLocalLength) + "@example.com",
+			ParseAddressAccepts: true,
+		},
+		{
+			Name:                "longest label",
+			Address:             "a@" + strings.Repeat("b", MaxLabelLength) + ".example",
+			ParseAddressAccepts: true,
+		},
+		{Name: "longest address", Address: longest(), ParseAddressAccepts: true},
+	}
+}
+
+// Invalid returns a fresh copy of the invalid address corpus. Each entry
+// breaks RFC 5321 or RFC 5322 as an address on its own:
+//
+//	empty string               ""
+//	missing @                  "userexample.com"
+//	missing local part         "@example.com"
+//	missing domain             "user@"
+//	double @                   "user@@example.com"
+//	two @ signs                "a@b@example.com"
+//	double dot in local part   "john..doe@example.com"
+//	leading dot                ".john@example.com"
+//	trailing dot in local part "john.@example.com"
+//	trailing dot in domain     "user@example.com."
+//	double dot in domain       "user@example..com"
+//	unquoted space             "user name@example.com"
+//	space in domain            "user@exa mple.com"
+//	unterminated quote         "\"user@example.com"
+//	unclosed IP literal        "user@[192.0.2.1"
+//	trailing newline           "user@example.com\n"
+//	leading hyphen in label    "user@-example.com"
+//	trailing hyphen in label   "user@example-.com"
+//	underscore in domain       "user@exa_mple.com"
+//	overlong local part        65 "a"s at example.com
+//	overlong label             "a@" and a 64-character label
+//	overlong address           255 characters
+//	display name               "User <user@example.com>"
+//	comment                    "user@example.com (comment)"
+//
+// Entries that net/mail accepts have ParseAddressAccepts set: it does not
+// check hostname syntax or lengths, and it parses a full mailbox, so a
+// display name or comment is stripped rather than rejected.
+func Invalid() []Address {
+	return []Address{
+		{Name: "empty string", Address: ""},
+		{Name: "missing @", Address: "userexample.com"},
+		{Name: "missing local part", Address: "@example.com"},
+		{Name: "missing domain", Address: "user@"},
+		{Name: "double @", Address: "user@@example.com"},
+		{Name: "two @ signs", Address: "a@b@example.com"},
+		{Name: "double dot in local part", Address: "john..doe@example.com"},
+		{Name: "leading dot", Address: ".john@example.com"},
+		{Name: "trailing dot in local part", Address: "john.@example.com"},
+		{Name: "trailing dot in domain", Address: "user@example.com."},
+		{Name: "double dot in domain", Address: "user@example..com"},
+		{Name: "unquoted space", Address: "user name@example.com"},
+		{Name: "space in domain", Address: "user@exa mple.com"},
+		{Name: "unterminated quote", Address: `"user@example.com`},
+		{Name: "unclosed IP literal", Address: "user@[192.0.2.1"},
+		{Name: "trailing newline", Address: "user@example.com\n"},
+		{Name: "leading hyphen in label", Address: "user@-example.com", ParseAddressAccepts: true},
+		{Name: "trailing hyphen in label", Address: "user@example-.com", ParseAddressAccepts: true},
+		{Name: "underscore in domain", Address: "user@exa_mple.com", ParseAddressAccepts: true},
+		{
+			Name:                "overlong local part",
+			Address:             strings.Repeat("a", MaxLocalLength+1) + "@example.com",
+			ParseAddressAccepts: true,
+		},
+		{
+			Name:                "overlong label",
+			Address:             "a@" + strings.Repeat("b", MaxLabelLength+1) + ".example",
+			ParseAddressAccepts: true,
+		},
+		{Name: "overlong address", Address: longest() + "m", ParseAddressAccepts: true},
+		{Name: "display name", Address: "User <user@example.com>", ParseAddressAccepts: true},
+		{Name: "comment", Address: "user@example.com (comment)", ParseAddressAccepts: true},
+	}
+}
+
+// MustMatchParseAddress is a helper function that runs net/mail.ParseAddress
+// on a.Address and panics unless the outcome matches ParseAddressAccepts. It
+// returns what ParseAddress returned.
+func MustMatchParseAddress(a Address) (*mail.Address, error) {
+	parsed, err := mail.ParseAddress(a.Address)
+	switch {
+	case a.ParseAddressAccepts && err != nil:
+		panic(fmt.Sprintf("expected mail.ParseAddress to accept %q, got %v", a.Address, err))
+	case !a.ParseAddressAccepts && err == nil:
+		panic(fmt.Sprintf("expected mail.ParseAddress to reject %q", a.Address))
+	}
+	return parsed, err
+}
+
+// longest returns an address of exactly MaxLength characters, with a local
+// part of MaxLocalLength and labels no longer than MaxLabelLength.
+func longest() string {
+	local := strings.Repeat("a", MaxLocalLength)
+	label := strings.Repeat("b", MaxLabelLength)
+	domain := label + "." + label + ".example"
+	domain = strings.Repeat("c", MaxLength-len(local)-1-len(domain)-1) + "." + domain
+	return local + "@" + domain
+}
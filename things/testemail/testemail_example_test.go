@@ -0,0 +1,32 @@
+package testemail
+
+import (
+	"fmt"
+)
+
+func ExampleInvalid() {
+	for _, a := range Invalid() {
+		if a.ParseAddressAccepts {
+			fmt.Println(a.Name)
+		}
+	}
+	// Output:
+	// leading hyphen in label
+	// trailing hyphen in label
+	// underscore in domain
+	// overlong local part
+	// overlong label
+	// overlong address
+	// display name
+	// comment
+}
+
+func ExampleMustMatchParseAddress() {
+	parsed, _ := MustMatchParseAddress(Address{
+		Name:                "display name",
+		Address:             "User <user@example.com>",
+		ParseAddressAccepts: true,
+	})
+	fmt.Println(parsed.Address)
+	// Output: user@example.com
+}
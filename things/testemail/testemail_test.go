@@ -0,0 +1,154 @@
+package testemail
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	t.Parallel()
+	checkCorpus(t, Valid())
+
+	for _, a := range Valid() {
+		if !a.ParseAddressAccepts {
+			t.Errorf("Expected net/mail to accept valid address %q", a.Name)
+		}
+		if len(a.Address) > MaxLength {
+			t.Errorf(
+				"Expected %q to fit in %d characters, got %d",
+				a.Name,
+				MaxLength,
+				len(a.Address),
+			)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	t.Parallel()
+	checkCorpus(t, Invalid())
+}
+
+// checkCorpus verifies names are unique and every ParseAddressAccepts flag
+// matches net/mail.
+func checkCorpus(t *testing.T, corpus []Address) {
+	t.Helper()
+	seen := make(map[string]bool)
+	for _, a := range corpus {
+		if seen[a.Name] {
+			t.Fatalf("Duplicate entry name %q", a.Name)
+		}
+		seen[a.Name] = true
+
+		t.Run(a.Name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("Expected the flag to match net/mail: %v", r)
+				}
+			}()
+			MustMatchParseAddress(a)
+		})
+	}
+}
+
+func TestLengthLimits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		address string
+		local   int
+		label   int
+		total   int
+	}{
+		{"longest local part", find(t, Valid(), "longest local part"), MaxLocalLength, 7, 76},
+		{"longest label", find(t, Valid(), "longest label"), 1, MaxLabelLength, 73},
+		{
+			"longest address",
+			find(t, Valid(), "longest address"),
+			MaxLocalLength,
+			MaxLabelLength,
+			MaxLength,
+		},
+		{
+			"overlong local part",
+			find(t, Invalid(), "overlong local part"),
+			MaxLocalLength + 1,
+			7,
+			77,
+		},
+		{"overlong label", find(t, Invalid(), "overlong label"), 1, MaxLabelLength + 1, 74},
+		{
+			"overlong address",
+			find(t, Invalid(), "overlong address"),
+			MaxLocalLength,
+			MaxLabelLength,
+			MaxLength + 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			local, domain, _ := strings.Cut(tc.address, "@")
+			label := 0
+			for _, l := range strings.Split(domain, ".") {
+				label = max(label, len(l))
+			}
+			if len(local) != tc.local || label != tc.label || len(tc.address) != tc.total {
+				t.Errorf("Expected local %d, label %d, total %d, got %d, %d, %d",
+					tc.local, tc.label, tc.total, len(local), label, len(tc.address))
+			}
+		})
+	}
+}
+
+func TestMustMatchParseAddress(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name  string
+		entry Address
+		panic bool
+	}{
+		{
+			"Accepted as expected",
+			Address{Address: "user@example.com", ParseAddressAccepts: true},
+			false,
+		},
+		{"Rejected as expected", Address{Address: "user@"}, false},
+		{"Unexpectedly rejected", Address{Address: "user@", ParseAddressAccepts: true}, true},
+		{"Unexpectedly accepted", Address{Address: "user@example.com"}, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); (r != nil) != tc.panic {
+					t.Errorf("Expected panic to be %t, got %v", tc.panic, r)
+				}
+			}()
+			MustMatchParseAddress(tc.entry)
+		})
+	}
+}
+
+func TestReturnsCopy(t *testing.T) {
+	t.Parallel()
+	v, i := Valid(), Invalid()
+	v[0].Address, i[0].Address = "changed", "changed"
+	if Valid()[0].Address == "changed" || Invalid()[0].Address == "changed" {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
+
+func find(t *testing.T, corpus []Address, name string) string {
+	t.Helper()
+	for _, a := range corpus {
+		if a.Name == name {
+			return a.Address
+		}
+	}
+	t.Fatalf("No entry named %q", name)
+	return ""
+}
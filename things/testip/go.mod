@@ -0,0 +1,3 @@
+module github.com/madflojo/testlazy/things/testip
+
+go 1.24.3
@@ -0,0 +1,198 @@
+package testip
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// InvalidString is a raw IP address string that net.ParseIP refuses to
+// parse, along with the error netip.ParseAddr is expected to return.
+type InvalidString struct {
+	// Name briefly describes what is wrong with Input.
+	Name string
+
+	// Input is the raw string to hand to the parser.
+	Input string
+
+	// ParseAddrErr is a substring of the error netip.ParseAddr returns for
+	// Input. It is empty when netip.ParseAddr accepts Input.
+	ParseAddrErr string
+}
+
+// InvalidStrings returns a fresh copy of the invalid IP string corpus. Every
+// entry makes net.ParseIP return nil:
+//
+//	empty string            ""
+//	octet above 255         "256.0.0.1"
+//	too few octets          "1.2.3"
+//	too many octets         "1.2.3.4.5"
+//	leading zero            "01.2.3.4"
+//	trailing dot            "1.2.3.4."
+//	trailing space          "1.2.3.4 "
+//	leading space           " 1.2.3.4"
+//	negative octet          "1.2.3.-4"
+//	hex octet               "0x7f.0.0.1"
+//	decimal integer         "2130706433"
+//	with port               "1.2.3.4:80"
+//	with prefix length      "1.2.3.4/24"
+//	hostname                "example.com"
+//	multiple double colons  "1::2::3"
+//	group too long          "12345::1"
+//	non-hex digit           "2001:db8::g"
+//	too many groups         "2001:db8:0:0:0:0:0:0:1"
+//	too few groups          "1:2:3:4:5:6:7"
+//	brackets                "[::1]"
+//	empty zone              "fe80::1%"
+//	short mapped IPv4       "::ffff:1.2.3"
+//	zone                    "fe80::1%eth0"
+//
+// The last is accepted by netip.ParseAddr, which keeps the zone, and rejected
+// only by net.ParseIP.
+func InvalidStrings() []InvalidString {
+	return []InvalidString{
+		{
+			Name:         "empty string",
+			Input:        "",
+			ParseAddrErr: "unable to parse IP",
+		},
+		{
+			Name:         "octet above 255",
+			Input:        "256.0.0.1",
+			ParseAddrErr: "IPv4 field has value >255",
+		},
+		{
+			Name:         "too few octets",
+			Input:        "1.2.3",
+			ParseAddrErr: "IPv4 address too short",
+		},
+		{
+			Name:         "too many octets",
+			Input:        "1.2.3.4.5",
+			ParseAddrErr: "IPv4 address too long",
+		},
+		{
+			Name:         "leading zero",
+			Input:        "01.2.3.4",
+			ParseAddrErr: "IPv4 field has octet with leading zero",
+		},
+		{
+			Name:         "trailing dot",
+			Input:        "1.2.3.4.",
+			ParseAddrErr: "IPv4 field must have at least one digit",
+		},
+		{
+			Name:         "trailing space",
+			Input:        "1.2.3.4 ",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "leading space",
+			Input:        " 1.2.3.4",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "negative octet",
+			Input:        "1.2.3.-4",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "hex octet",
+			Input:        "0x7f.0.0.1",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "decimal integer",
+			Input:        "2130706433",
+			ParseAddrErr: "unable to parse IP",
+		},
+		{
+			Name:         "with port",
+			Input:        "1.2.3.4:80",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "with prefix length",
+			Input:        "1.2.3.4/24",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "hostname",
+			Input:        "example.com",
+			ParseAddrErr: "unexpected character",
+		},
+		{
+			Name:         "multiple double colons",
+			Input:        "1::2::3",
+			ParseAddrErr: "multiple :: in address",
+		},
+		{
+			Name:         "group too long",
+			Input:        "12345::1",
+			ParseAddrErr: "each group must have 4 or less digits",
+		},
+		{
+			Name:         "non-hex digit",
+			Input:        "2001:db8::g",
+			ParseAddrErr: "each colon-separated field must have at least one digit",
+		},
+		{
+			Name:         "too many groups",
+			Input:        "2001:db8:0:0:0:0:0:0:1",
+			ParseAddrErr: "trailing garbage after address",
+		},
+		{
+			Name:         "too few groups",
+			Input:        "1:2:3:4:5:6:7",
+			ParseAddrErr: "address string too short",
+		},
+		{
+			Name:         "brackets",
+			Input:        "[::1]",
+			ParseAddrErr: "each colon-separated field must have at least one digit",
+		},
+		{
+			Name:         "empty zone",
+			Input:        "fe80::1%",
+			ParseAddrErr: "zone must be a non-empty string",
+		},
+		{
+			Name:         "short mapped IPv4",
+			Input:        "::ffff:1.2.3",
+			ParseAddrErr: "IPv4 address too short",
+		},
+		{
+			Name:  "zone",
+			Input: "fe80::1%eth0",
+		},
+	}
+}
+
+// MustFailParse is a helper function that checks an InvalidString against
+// net.ParseIP and netip.ParseAddr and panics if either parser disagrees with
+// the entry. It returns the netip.ParseAddr error, which is nil when
+// netip.ParseAddr is expected to succeed.
+func MustFailParse(s InvalidString) error {
+	if ip := net.ParseIP(s.Input); ip != nil {
+		panic(fmt.Sprintf("expected net.ParseIP to reject %q, got %s", s.Input, ip))
+	}
+
+	_, err := netip.ParseAddr(s.Input)
+	switch {
+	case s.ParseAddrErr == "" && err != nil:
+		panic(fmt.Sprintf("expected netip.ParseAddr to accept %q, got %v", s.Input, err))
+	case s.ParseAddrErr != "" && err == nil:
+		panic(fmt.Sprintf("expected netip.ParseAddr to reject %q", s.Input))
+	case s.ParseAddrErr != "" && !strings.Contains(err.Error(), s.ParseAddrErr):
+		panic(
+			fmt.Sprintf(
+				"expected netip.ParseAddr error for %q to contain %q, got %v",
+				s.Input,
+				s.ParseAddrErr,
+				err,
+			),
+		)
+	}
+	return err
+}
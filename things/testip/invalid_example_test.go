@@ -0,0 +1,28 @@
+package testip
+
+import (
+	"fmt"
+	"net"
+)
+
+// ExampleInvalidStrings demonstrates feeding the corpus to net.ParseIP.
+func ExampleInvalidStrings() {
+	for _, s := range InvalidStrings()[1:4] {
+		fmt.Println(s.Name, "->", net.ParseIP(s.Input) == nil)
+	}
+	// Output:
+	// octet above 255 -> true
+	// too few octets -> true
+	// too many octets -> true
+}
+
+// ExampleMustFailParse demonstrates verifying a single corpus entry.
+func ExampleMustFailParse() {
+	err := MustFailParse(InvalidString{
+		Name:         "leading zero",
+		Input:        "01.2.3.4",
+		ParseAddrErr: "leading zero",
+	})
+	fmt.Println(err)
+	// Output: ParseAddr("01.2.3.4"): IPv4 field has octet with leading zero
+}
@@ -0,0 +1,96 @@
+package testip
+
+import (
+	"testing"
+)
+
+func TestInvalidStrings(t *testing.T) {
+	t.Parallel()
+
+	entries := InvalidStrings()
+	if len(entries) == 0 {
+		t.Fatal("Expected a non-empty corpus")
+	}
+
+	seen := make(map[string]bool)
+	for _, tc := range entries {
+		if seen[tc.Name] {
+			t.Fatalf("Duplicate entry name %q", tc.Name)
+		}
+		seen[tc.Name] = true
+
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := MustFailParse(tc)
+			if (err != nil) != (tc.ParseAddrErr != "") {
+				t.Errorf(
+					"Expected MustFailParse error to match ParseAddrErr %q, got %v",
+					tc.ParseAddrErr,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestInvalidStringsReturnsCopy(t *testing.T) {
+	t.Parallel()
+
+	first := InvalidStrings()
+	first[0].Input = "changed"
+	if InvalidStrings()[0].Input == "changed" {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
+
+func TestMustFailParse(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name  string
+		entry InvalidString
+		panic bool
+	}{
+		{
+			name:  "Matching entry",
+			entry: InvalidString{Input: "1.2.3", ParseAddrErr: "too short"},
+			panic: false,
+		},
+		{
+			name:  "Zone accepted by ParseAddr",
+			entry: InvalidString{Input: "fe80::1%eth0"},
+			panic: false,
+		},
+		{
+			name:  "ParseIP unexpectedly succeeds",
+			entry: InvalidString{Input: IPv4TestNet1String, ParseAddrErr: "anything"},
+			panic: true,
+		},
+		{
+			name:  "ParseAddr unexpectedly succeeds",
+			entry: InvalidString{Input: "fe80::1%eth0", ParseAddrErr: "anything"},
+			panic: true,
+		},
+		{
+			name:  "ParseAddr unexpectedly fails",
+			entry: InvalidString{Input: "1.2.3"},
+			panic: true,
+		},
+		{
+			name:  "Error mismatch",
+			entry: InvalidString{Input: "1.2.3", ParseAddrErr: "leading zero"},
+			panic: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); (r != nil) != tc.panic {
+					t.Errorf("Expected panic to be %t, got %v", tc.panic, r)
+				}
+			}()
+			MustFailParse(tc.entry)
+		})
+	}
+}
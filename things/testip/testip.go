@@ -0,0 +1,372 @@
+/*
+Package testip provides canonical IP addresses for tests as net.IP,
+netip.Addr, and netip.Prefix values, so there is no need to remember which
+block is private, which is link-local, or what TEST-NET-2 was again.
+
+	github.com/madflojo/testlazy/things/testip
+
+Every address comes in three forms: IPv4Loopback returns a net.IP,
+IPv4LoopbackAddr a netip.Addr, and IPv4LoopbackPrefix the netip.Prefix of
+the block it belongs to. The matching string constants hold the raw values.
+Like net.ParseIP, the net.IP functions return the 16-byte form, IPv4
+included.
+
+Example usage
+
+	if isPublic(testip.IPv4Private10()) {
+	    t.Error("10.0.0.1 should not be treated as public")
+	}
+
+	allow := []netip.Prefix{testip.IPv4TestNet1Prefix()}
+
+InvalidStrings lists malformed addresses for parser error paths. Each call
+returns a fresh value, so tests may modify what they get.
+*/
+package testip
+
+import (
+	"net"
+	"net/netip"
+)
+
+// Addresses in string form, as accepted by net.ParseIP and netip.ParseAddr.
+const (
+	IPv4LoopbackString      = "127.0.0.1"
+	IPv4UnspecifiedString   = "0.0.0.0"
+	IPv4Private10String     = "10.0.0.1"
+	IPv4Private172String    = "172.16.0.1"
+	IPv4Private192String    = "192.168.0.1"
+	IPv4LinkLocalString     = "169.254.0.1"
+	IPv4MulticastString     = "224.0.0.1"
+	IPv4BroadcastString     = "255.255.255.255"
+	IPv4TestNet1String      = "192.0.2.1"
+	IPv4TestNet2String      = "198.51.100.1"
+	IPv4TestNet3String      = "203.0.113.1"
+	IPv4CGNATString         = "100.64.0.1"
+	IPv6LoopbackString      = "::1"
+	IPv6UnspecifiedString   = "::"
+	IPv6ULAString           = "fd00::1"
+	IPv6LinkLocalString     = "fe80::1"
+	IPv6MulticastString     = "ff02::1"
+	IPv6DocumentationString = "2001:db8::1"
+	IPv4MappedString        = "::ffff:192.0.2.1"
+)
+
+// Prefixes in CIDR notation, as accepted by netip.ParsePrefix.
+const (
+	IPv4LoopbackCIDR      = "127.0.0.0/8"
+	IPv4UnspecifiedCIDR   = "0.0.0.0/32"
+	IPv4Private10CIDR     = "10.0.0.0/8"
+	IPv4Private172CIDR    = "172.16.0.0/12"
+	IPv4Private192CIDR    = "192.168.0.0/16"
+	IPv4LinkLocalCIDR     = "169.254.0.0/16"
+	IPv4MulticastCIDR     = "224.0.0.0/4"
+	IPv4BroadcastCIDR     = "255.255.255.255/32"
+	IPv4TestNet1CIDR      = "192.0.2.0/24"
+	IPv4TestNet2CIDR      = "198.51.100.0/24"
+	IPv4TestNet3CIDR      = "203.0.113.0/24"
+	IPv4CGNATCIDR         = "100.64.0.0/10"
+	IPv6LoopbackCIDR      = "::1/128"
+	IPv6UnspecifiedCIDR   = "::/128"
+	IPv6ULACIDR           = "fc00::/7"
+	IPv6LinkLocalCIDR     = "fe80::/10"
+	IPv6MulticastCIDR     = "ff00::/8"
+	IPv6DocumentationCIDR = "2001:db8::/32"
+	IPv4MappedCIDR        = "::ffff:0.0.0.0/96"
+)
+
+// IPv4Loopback returns 127.0.0.1 as a net.IP, the IPv4 loopback address.
+func IPv4Loopback() net.IP {
+	return net.ParseIP(IPv4LoopbackString)
+}
+
+// IPv4LoopbackAddr returns 127.0.0.1 as a netip.Addr.
+func IPv4LoopbackAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4LoopbackString)
+}
+
+// IPv4LoopbackPrefix returns 127.0.0.0/8, the IPv4 loopback block.
+func IPv4LoopbackPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4LoopbackCIDR)
+}
+
+// IPv4Unspecified returns 0.0.0.0 as a net.IP, the IPv4 unspecified address.
+func IPv4Unspecified() net.IP {
+	return net.ParseIP(IPv4UnspecifiedString)
+}
+
+// IPv4UnspecifiedAddr returns 0.0.0.0 as a netip.Addr.
+func IPv4UnspecifiedAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4UnspecifiedString)
+}
+
+// IPv4UnspecifiedPrefix returns 0.0.0.0/32, the single unspecified address.
+func IPv4UnspecifiedPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4UnspecifiedCIDR)
+}
+
+// IPv4Private10 returns 10.0.0.1 as a net.IP, an RFC 1918 private address.
+func IPv4Private10() net.IP {
+	return net.ParseIP(IPv4Private10String)
+}
+
+// IPv4Private10Addr returns 10.0.0.1 as a netip.Addr.
+func IPv4Private10Addr() netip.Addr {
+	return netip.MustParseAddr(IPv4Private10String)
+}
+
+// IPv4Private10Prefix returns 10.0.0.0/8, the RFC 1918 10/8 block.
+func IPv4Private10Prefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4Private10CIDR)
+}
+
+// IPv4Private172 returns 172.16.0.1 as a net.IP, an RFC 1918 private address.
+func IPv4Private172() net.IP {
+	return net.ParseIP(IPv4Private172String)
+}
+
+// IPv4Private172Addr returns 172.16.0.1 as a netip.Addr.
+func IPv4Private172Addr() netip.Addr {
+	return netip.MustParseAddr(IPv4Private172String)
+}
+
+// IPv4Private172Prefix returns 172.16.0.0/12, the RFC 1918 172.16/12 block.
+func IPv4Private172Prefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4Private172CIDR)
+}
+
+// IPv4Private192 returns 192.168.0.1 as a net.IP, an RFC 1918 private address.
+func IPv4Private192() net.IP {
+	return net.ParseIP(IPv4Private192String)
+}
+
+// IPv4Private192Addr returns 192.168.0.1 as a netip.Addr.
+func IPv4Private192Addr() netip.Addr {
+	return netip.MustParseAddr(IPv4Private192String)
+}
+
+// IPv4Private192Prefix returns 192.168.0.0/16, the RFC 1918 192.168/16 block.
+func IPv4Private192Prefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4Private192CIDR)
+}
+
+// IPv4LinkLocal returns 169.254.0.1 as a net.IP, an IPv4 link-local address.
+func IPv4LinkLocal() net.IP {
+	return net.ParseIP(IPv4LinkLocalString)
+}
+
+// IPv4LinkLocalAddr returns 169.254.0.1 as a netip.Addr.
+func IPv4LinkLocalAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4LinkLocalString)
+}
+
+// IPv4LinkLocalPrefix returns 169.254.0.0/16, the IPv4 link-local block.
+func IPv4LinkLocalPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4LinkLocalCIDR)
+}
+
+// IPv4Multicast returns 224.0.0.1 as a net.IP, the IPv4 all-hosts multicast group.
+func IPv4Multicast() net.IP {
+	return net.ParseIP(IPv4MulticastString)
+}
+
+// IPv4MulticastAddr returns 224.0.0.1 as a netip.Addr.
+func IPv4MulticastAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4MulticastString)
+}
+
+// IPv4MulticastPrefix returns 224.0.0.0/4, the IPv4 multicast block.
+func IPv4MulticastPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4MulticastCIDR)
+}
+
+// IPv4Broadcast returns 255.255.255.255 as a net.IP, the IPv4 limited broadcast address.
+func IPv4Broadcast() net.IP {
+	return net.ParseIP(IPv4BroadcastString)
+}
+
+// IPv4BroadcastAddr returns 255.255.255.255 as a netip.Addr.
+func IPv4BroadcastAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4BroadcastString)
+}
+
+// IPv4BroadcastPrefix returns 255.255.255.255/32, the single broadcast address.
+func IPv4BroadcastPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4BroadcastCIDR)
+}
+
+// IPv4TestNet1 returns 192.0.2.1 as a net.IP, an address from TEST-NET-1,
+// reserved for documentation by RFC 5737.
+func IPv4TestNet1() net.IP {
+	return net.ParseIP(IPv4TestNet1String)
+}
+
+// IPv4TestNet1Addr returns 192.0.2.1 as a netip.Addr.
+func IPv4TestNet1Addr() netip.Addr {
+	return netip.MustParseAddr(IPv4TestNet1String)
+}
+
+// IPv4TestNet1Prefix returns 192.0.2.0/24, TEST-NET-1.
+func IPv4TestNet1Prefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4TestNet1CIDR)
+}
+
+// IPv4TestNet2 returns 198.51.100.1 as a net.IP, an address from TEST-NET-2,
+// reserved for documentation by RFC 5737.
+func IPv4TestNet2() net.IP {
+	return net.ParseIP(IPv4TestNet2String)
+}
+
+// IPv4TestNet2Addr returns 198.51.100.1 as a netip.Addr.
+func IPv4TestNet2Addr() netip.Addr {
+	return netip.MustParseAddr(IPv4TestNet2String)
+}
+
+// IPv4TestNet2Prefix returns 198.51.100.0/24, TEST-NET-2.
+func IPv4TestNet2Prefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4TestNet2CIDR)
+}
+
+// IPv4TestNet3 returns 203.0.113.1 as a net.IP, an address from TEST-NET-3,
+// reserved for documentation by RFC 5737.
+func IPv4TestNet3() net.IP {
+	return net.ParseIP(IPv4TestNet3String)
+}
+
+// IPv4TestNet3Addr returns 203.0.113.1 as a netip.Addr.
+func IPv4TestNet3Addr() netip.Addr {
+	return netip.MustParseAddr(IPv4TestNet3String)
+}
+
+// IPv4TestNet3Prefix returns 203.0.113.0/24, TEST-NET-3.
+func IPv4TestNet3Prefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4TestNet3CIDR)
+}
+
+// IPv4CGNAT returns 100.64.0.1 as a net.IP, an RFC 6598 shared address used for carrier-grade NAT.
+func IPv4CGNAT() net.IP {
+	return net.ParseIP(IPv4CGNATString)
+}
+
+// IPv4CGNATAddr returns 100.64.0.1 as a netip.Addr.
+func IPv4CGNATAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4CGNATString)
+}
+
+// IPv4CGNATPrefix returns 100.64.0.0/10, the RFC 6598 shared address block.
+func IPv4CGNATPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4CGNATCIDR)
+}
+
+// IPv6Loopback returns ::1 as a net.IP, the IPv6 loopback address.
+func IPv6Loopback() net.IP {
+	return net.ParseIP(IPv6LoopbackString)
+}
+
+// IPv6LoopbackAddr returns ::1 as a netip.Addr.
+func IPv6LoopbackAddr() netip.Addr {
+	return netip.MustParseAddr(IPv6LoopbackString)
+}
+
+// IPv6LoopbackPrefix returns ::1/128, the single IPv6 loopback address.
+func IPv6LoopbackPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv6LoopbackCIDR)
+}
+
+// IPv6Unspecified returns :: as a net.IP, the IPv6 unspecified address.
+func IPv6Unspecified() net.IP {
+	return net.ParseIP(IPv6UnspecifiedString)
+}
+
+// IPv6UnspecifiedAddr returns :: as a netip.Addr.
+func IPv6UnspecifiedAddr() netip.Addr {
+	return netip.MustParseAddr(IPv6UnspecifiedString)
+}
+
+// IPv6UnspecifiedPrefix returns ::/128, the single IPv6 unspecified address.
+func IPv6UnspecifiedPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv6UnspecifiedCIDR)
+}
+
+// IPv6ULA returns fd00::1 as a net.IP, an IPv6 unique local address, the
+// IPv6 counterpart of RFC 1918.
+func IPv6ULA() net.IP {
+	return net.ParseIP(IPv6ULAString)
+}
+
+// IPv6ULAAddr returns fd00::1 as a netip.Addr.
+func IPv6ULAAddr() netip.Addr {
+	return netip.MustParseAddr(IPv6ULAString)
+}
+
+// IPv6ULAPrefix returns fc00::/7, the unique local block.
+func IPv6ULAPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv6ULACIDR)
+}
+
+// IPv6LinkLocal returns fe80::1 as a net.IP, an IPv6 link-local address.
+func IPv6LinkLocal() net.IP {
+	return net.ParseIP(IPv6LinkLocalString)
+}
+
+// IPv6LinkLocalAddr returns fe80::1 as a netip.Addr.
+func IPv6LinkLocalAddr() netip.Addr {
+	return netip.MustParseAddr(IPv6LinkLocalString)
+}
+
+// IPv6LinkLocalPrefix returns fe80::/10, the IPv6 link-local block.
+func IPv6LinkLocalPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv6LinkLocalCIDR)
+}
+
+// IPv6Multicast returns ff02::1 as a net.IP, the IPv6 link-local all-nodes multicast group.
+func IPv6Multicast() net.IP {
+	return net.ParseIP(IPv6MulticastString)
+}
+
+// IPv6MulticastAddr returns ff02::1 as a netip.Addr.
+func IPv6MulticastAddr() netip.Addr {
+	return netip.MustParseAddr(IPv6MulticastString)
+}
+
+// IPv6MulticastPrefix returns ff00::/8, the IPv6 multicast block.
+func IPv6MulticastPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv6MulticastCIDR)
+}
+
+// IPv6Documentation returns 2001:db8::1 as a net.IP, an address from the
+// IPv6 documentation prefix of RFC 3849.
+func IPv6Documentation() net.IP {
+	return net.ParseIP(IPv6DocumentationString)
+}
+
+// IPv6DocumentationAddr returns 2001:db8::1 as a netip.Addr.
+func IPv6DocumentationAddr() netip.Addr {
+	return netip.MustParseAddr(IPv6DocumentationString)
+}
+
+// IPv6DocumentationPrefix returns 2001:db8::/32, the IPv6 documentation prefix.
+func IPv6DocumentationPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv6DocumentationCIDR)
+}
+
+// IPv4Mapped returns ::ffff:192.0.2.1 as a net.IP, the TEST-NET-1 address
+// 192.0.2.1 written as an IPv4-mapped IPv6 address.
+//
+// net.IP cannot tell a mapped address from the IPv4 one, so To4 is non-nil
+// and String returns "192.0.2.1". Use IPv4MappedAddr to keep the distinction.
+func IPv4Mapped() net.IP {
+	return net.ParseIP(IPv4MappedString)
+}
+
+// IPv4MappedAddr returns ::ffff:192.0.2.1 as a netip.Addr.
+// Is4In6 reports true and String keeps the "::ffff:" prefix; Unmap returns
+// the plain IPv4 address.
+func IPv4MappedAddr() netip.Addr {
+	return netip.MustParseAddr(IPv4MappedString)
+}
+
+// IPv4MappedPrefix returns ::ffff:0.0.0.0/96, the IPv4-mapped block.
+func IPv4MappedPrefix() netip.Prefix {
+	return netip.MustParsePrefix(IPv4MappedCIDR)
+}
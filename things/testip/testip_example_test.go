@@ -0,0 +1,290 @@
+package testip
+
+import (
+	"fmt"
+)
+
+func ExampleIPv4Loopback() {
+	fmt.Println(IPv4Loopback())
+	// Output: 127.0.0.1
+}
+
+func ExampleIPv4LoopbackAddr() {
+	fmt.Println(IPv4LoopbackAddr())
+	// Output: 127.0.0.1
+}
+
+func ExampleIPv4LoopbackPrefix() {
+	fmt.Println(IPv4LoopbackPrefix())
+	// Output: 127.0.0.0/8
+}
+
+func ExampleIPv4Unspecified() {
+	fmt.Println(IPv4Unspecified())
+	// Output: 0.0.0.0
+}
+
+func ExampleIPv4UnspecifiedAddr() {
+	fmt.Println(IPv4UnspecifiedAddr())
+	// Output: 0.0.0.0
+}
+
+func ExampleIPv4UnspecifiedPrefix() {
+	fmt.Println(IPv4UnspecifiedPrefix())
+	// Output: 0.0.0.0/32
+}
+
+func ExampleIPv4Private10() {
+	fmt.Println(IPv4Private10())
+	// Output: 10.0.0.1
+}
+
+func ExampleIPv4Private10Addr() {
+	fmt.Println(IPv4Private10Addr())
+	// Output: 10.0.0.1
+}
+
+func ExampleIPv4Private10Prefix() {
+	fmt.Println(IPv4Private10Prefix())
+	// Output: 10.0.0.0/8
+}
+
+func ExampleIPv4Private172() {
+	fmt.Println(IPv4Private172())
+	// Output: 172.16.0.1
+}
+
+func ExampleIPv4Private172Addr() {
+	fmt.Println(IPv4Private172Addr())
+	// Output: 172.16.0.1
+}
+
+func ExampleIPv4Private172Prefix() {
+	fmt.Println(IPv4Private172Prefix())
+	// Output: 172.16.0.0/12
+}
+
+func ExampleIPv4Private192() {
+	fmt.Println(IPv4Private192())
+	// Output: 192.168.0.1
+}
+
+func ExampleIPv4Private192Addr() {
+	fmt.Println(IPv4Private192Addr())
+	// Output: 192.168.0.1
+}
+
+func ExampleIPv4Private192Prefix() {
+	fmt.Println(IPv4Private192Prefix())
+	// Output: 192.168.0.0/16
+}
+
+func ExampleIPv4LinkLocal() {
+	fmt.Println(IPv4LinkLocal())
+	// Output: 169.254.0.1
+}
+
+func ExampleIPv4LinkLocalAddr() {
+	fmt.Println(IPv4LinkLocalAddr())
+	// Output: 169.254.0.1
+}
+
+func ExampleIPv4LinkLocalPrefix() {
+	fmt.Println(IPv4LinkLocalPrefix())
+	// Output: 169.254.0.0/16
+}
+
+func ExampleIPv4Multicast() {
+	fmt.Println(IPv4Multicast())
+	// Output: 224.0.0.1
+}
+
+func ExampleIPv4MulticastAddr() {
+	fmt.Println(IPv4MulticastAddr())
+	// Output: 224.0.0.1
+}
+
+func ExampleIPv4MulticastPrefix() {
+	fmt.Println(IPv4MulticastPrefix())
+	// Output: 224.0.0.0/4
+}
+
+func ExampleIPv4Broadcast() {
+	fmt.Println(IPv4Broadcast())
+	// Output: 255.255.255.255
+}
+
+func ExampleIPv4BroadcastAddr() {
+	fmt.Println(IPv4BroadcastAddr())
+	// Output: 255.255.255.255
+}
+
+func ExampleIPv4BroadcastPrefix() {
+	fmt.Println(IPv4BroadcastPrefix())
+	// Output: 255.255.255.255/32
+}
+
+func ExampleIPv4TestNet1() {
+	fmt.Println(IPv4TestNet1())
+	// Output: 192.0.2.1
+}
+
+func ExampleIPv4TestNet1Addr() {
+	fmt.Println(IPv4TestNet1Addr())
+	// Output: 192.0.2.1
+}
+
+func ExampleIPv4TestNet1Prefix() {
+	fmt.Println(IPv4TestNet1Prefix())
+	// Output: 192.0.2.0/24
+}
+
+func ExampleIPv4TestNet2() {
+	fmt.Println(IPv4TestNet2())
+	// Output: 198.51.100.1
+}
+
+func ExampleIPv4TestNet2Addr() {
+	fmt.Println(IPv4TestNet2Addr())
+	// Output: 198.51.100.1
+}
+
+func ExampleIPv4TestNet2Prefix() {
+	fmt.Println(IPv4TestNet2Prefix())
+	// Output: 198.51.100.0/24
+}
+
+func ExampleIPv4TestNet3() {
+	fmt.Println(IPv4TestNet3())
+	// Output: 203.0.113.1
+}
+
+func ExampleIPv4TestNet3Addr() {
+	fmt.Println(IPv4TestNet3Addr())
+	// Output: 203.0.113.1
+}
+
+func ExampleIPv4TestNet3Prefix() {
+	fmt.Println(IPv4TestNet3Prefix())
+	// Output: 203.0.113.0/24
+}
+
+func ExampleIPv4CGNAT() {
+	fmt.Println(IPv4CGNAT())
+	// Output: 100.64.0.1
+}
+
+func ExampleIPv4CGNATAddr() {
+	fmt.Println(IPv4CGNATAddr())
+	// Output: 100.64.0.1
+}
+
+func ExampleIPv4CGNATPrefix() {
+	fmt.Println(IPv4CGNATPrefix())
+	// Output: 100.64.0.0/10
+}
+
+func ExampleIPv6Loopback() {
+	fmt.Println(IPv6Loopback())
+	// Output: ::1
+}
+
+func ExampleIPv6LoopbackAddr() {
+	fmt.Println(IPv6LoopbackAddr())
+	// Output: ::1
+}
+
+func ExampleIPv6LoopbackPrefix() {
+	fmt.Println(IPv6LoopbackPrefix())
+	// Output: ::1/128
+}
+
+func ExampleIPv6Unspecified() {
+	fmt.Println(IPv6Unspecified())
+	// Output: ::
+}
+
+func ExampleIPv6UnspecifiedAddr() {
+	fmt.Println(IPv6UnspecifiedAddr())
+	// Output: ::
+}
+
+func ExampleIPv6UnspecifiedPrefix() {
+	fmt.Println(IPv6UnspecifiedPrefix())
+	// Output: ::/128
+}
+
+func ExampleIPv6ULA() {
+	fmt.Println(IPv6ULA())
+	// Output: fd00::1
+}
+
+func ExampleIPv6ULAAddr() {
+	fmt.Println(IPv6ULAAddr())
+	// Output: fd00::1
+}
+
+func ExampleIPv6ULAPrefix() {
+	fmt.Println(IPv6ULAPrefix())
+	// Output: fc00::/7
+}
+
+func ExampleIPv6LinkLocal() {
+	fmt.Println(IPv6LinkLocal())
+	// Output: fe80::1
+}
+
+func ExampleIPv6LinkLocalAddr() {
+	fmt.Println(IPv6LinkLocalAddr())
+	// Output: fe80::1
+}
+
+func ExampleIPv6LinkLocalPrefix() {
+	fmt.Println(IPv6LinkLocalPrefix())
+	// Output: fe80::/10
+}
+
+func ExampleIPv6Multicast() {
+	fmt.Println(IPv6Multicast())
+	// Output: ff02::1
+}
+
+func ExampleIPv6MulticastAddr() {
+	fmt.Println(IPv6MulticastAddr())
+	// Output: ff02::1
+}
+
+func ExampleIPv6MulticastPrefix() {
+	fmt.Println(IPv6MulticastPrefix())
+	// Output: ff00::/8
+}
+
+func ExampleIPv6Documentation() {
+	fmt.Println(IPv6Documentation())
+	// Output: 2001:db8::1
+}
+
+func ExampleIPv6DocumentationAddr() {
+	fmt.Println(IPv6DocumentationAddr())
+	// Output: 2001:db8::1
+}
+
+func ExampleIPv6DocumentationPrefix() {
+	fmt.Println(IPv6DocumentationPrefix())
+	// Output: 2001:db8::/32
+}
+
+func ExampleIPv4Mapped() {
+	fmt.Println(IPv4Mapped())
+	// Output: 192.0.2.1
+}
+
+func ExampleIPv4MappedAddr() {
+	fmt.Println(IPv4MappedAddr())
+	// Output: ::ffff:192.0.2.1
+}
+
+func ExampleIPv4MappedPrefix() {
+	fmt.Println(IPv4MappedPrefix())
+	// Output: ::ffff:0.0.0.0/96
+}
@@ -0,0 +1,241 @@
+package testip
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+)
+
+// TestAddresses verifies every address in all three forms and that each
+// prefix contains its address.
+func TestAddresses(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		ip     func() net.IP
+		addr   func() netip.Addr
+		prefix func() netip.Prefix
+		want   string
+		cidr   string
+	}{
+		{
+			"IPv4Loopback",
+			IPv4Loopback,
+			IPv4LoopbackAddr,
+			IPv4LoopbackPrefix,
+			"127.0.0.1",
+			"127.0.0.0/8",
+		},
+		{
+			"IPv4Unspecified",
+			IPv4Unspecified,
+			IPv4UnspecifiedAddr,
+			IPv4UnspecifiedPrefix,
+			"0.0.0.0",
+			"0.0.0.0/32",
+		},
+		{
+			"IPv4Private10",
+			IPv4Private10,
+			IPv4Private10Addr,
+			IPv4Private10Prefix,
+			"10.0.0.1",
+			"10.0.0.0/8",
+		},
+		{
+			"IPv4Private172",
+			IPv4Private172,
+			IPv4Private172Addr,
+			IPv4Private172Prefix,
+			"172.16.0.1",
+			"172.16.0.0/12",
+		},
+		{
+			"IPv4Private192",
+			IPv4Private192,
+			IPv4Private192Addr,
+			IPv4Private192Prefix,
+			"192.168.0.1",
+			"192.168.0.0/16",
+		},
+		{
+			"IPv4LinkLocal",
+			IPv4LinkLocal,
+			IPv4LinkLocalAddr,
+			IPv4LinkLocalPrefix,
+			"169.254.0.1",
+			"169.254.0.0/16",
+		},
+		{
+			"IPv4Multicast",
+			IPv4Multicast,
+			IPv4MulticastAddr,
+			IPv4MulticastPrefix,
+			"224.0.0.1",
+			"224.0.0.0/4",
+		},
+		{
+			"IPv4Broadcast",
+			IPv4Broadcast,
+			IPv4BroadcastAddr,
+			IPv4BroadcastPrefix,
+			"255.255.255.255",
+			"255.255.255.255/32",
+		},
+		{
+			"IPv4TestNet1",
+			IPv4TestNet1,
+			IPv4TestNet1Addr,
+			IPv4TestNet1Prefix,
+			"192.0.2.1",
+			"192.0.2.0/24",
+		},
+		{
+			"IPv4TestNet2",
+			IPv4TestNet2,
+			IPv4TestNet2Addr,
+			IPv4TestNet2Prefix,
+			"198.51.100.1",
+			"198.51.100.0/24",
+		},
+		{
+			"IPv4TestNet3",
+			IPv4TestNet3,
+			IPv4TestNet3Addr,
+			IPv4TestNet3Prefix,
+			"203.0.113.1",
+			"203.0.113.0/24",
+		},
+		{"IPv4CGNAT", IPv4CGNAT, IPv4CGNATAddr, IPv4CGNATPrefix, "100.64.0.1", "100.64.0.0/10"},
+		{"IPv6Loopback", IPv6Loopback, IPv6LoopbackAddr, IPv6LoopbackPrefix, "::1", "::1/128"},
+		{
+			"IPv6Unspecified",
+			IPv6Unspecified,
+			IPv6UnspecifiedAddr,
+			IPv6UnspecifiedPrefix,
+			"::",
+			"::/128",
+		},
+		{"IPv6ULA", IPv6ULA, IPv6ULAAddr, IPv6ULAPrefix, "fd00::1", "fc00::/7"},
+		{
+			"IPv6LinkLocal",
+			IPv6LinkLocal,
+			IPv6LinkLocalAddr,
+			IPv6LinkLocalPrefix,
+			"fe80::1",
+			"fe80::/10",
+		},
+		{
+			"IPv6Multicast",
+			IPv6Multicast,
+			IPv6MulticastAddr,
+			IPv6MulticastPrefix,
+			"ff02::1",
+			"ff00::/8",
+		},
+		{
+			"IPv6Documentation",
+			IPv6Documentation,
+			IPv6DocumentationAddr,
+			IPv6DocumentationPrefix,
+			"2001:db8::1",
+			"2001:db8::/32",
+		},
+		{
+			"IPv4Mapped",
+			IPv4Mapped,
+			IPv4MappedAddr,
+			IPv4MappedPrefix,
+			"::ffff:192.0.2.1",
+			"::ffff:0.0.0.0/96",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			addr := tc.addr()
+			if addr.String() != tc.want {
+				t.Errorf("Expected Addr %q, got %q", tc.want, addr)
+			}
+			if !tc.ip().Equal(net.IP(addr.AsSlice())) {
+				t.Errorf("Expected net.IP %v to equal %v", tc.ip(), addr)
+			}
+			p := tc.prefix()
+			if p.String() != tc.cidr {
+				t.Errorf("Expected Prefix %q, got %q", tc.cidr, p)
+			}
+			if !p.Contains(addr) {
+				t.Errorf("Expected %s to contain %s", p, addr)
+			}
+			if p != p.Masked() {
+				t.Errorf("Expected %s to be masked", p)
+			}
+
+			ip := tc.ip()
+			ip[len(ip)-1]++
+			if !tc.ip().Equal(net.ParseIP(tc.want)) {
+				t.Error("Expected a fresh net.IP on every call")
+			}
+		})
+	}
+}
+
+// TestClassification checks each address against the net.IP and
+// netip.Addr predicates, so the categories stay honest.
+func TestClassification(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		addr netip.Addr
+		ok   func(netip.Addr) bool
+	}{
+		{"IPv4Loopback", IPv4LoopbackAddr(), netip.Addr.IsLoopback},
+		{"IPv6Loopback", IPv6LoopbackAddr(), netip.Addr.IsLoopback},
+		{"IPv4Unspecified", IPv4UnspecifiedAddr(), netip.Addr.IsUnspecified},
+		{"IPv6Unspecified", IPv6UnspecifiedAddr(), netip.Addr.IsUnspecified},
+		{"IPv4Private10", IPv4Private10Addr(), netip.Addr.IsPrivate},
+		{"IPv4Private172", IPv4Private172Addr(), netip.Addr.IsPrivate},
+		{"IPv4Private192", IPv4Private192Addr(), netip.Addr.IsPrivate},
+		{"IPv6ULA", IPv6ULAAddr(), netip.Addr.IsPrivate},
+		{"IPv4LinkLocal", IPv4LinkLocalAddr(), netip.Addr.IsLinkLocalUnicast},
+		{"IPv6LinkLocal", IPv6LinkLocalAddr(), netip.Addr.IsLinkLocalUnicast},
+		{"IPv4Multicast", IPv4MulticastAddr(), netip.Addr.IsLinkLocalMulticast},
+		{"IPv6Multicast", IPv6MulticastAddr(), netip.Addr.IsLinkLocalMulticast},
+		{"IPv4Mapped", IPv4MappedAddr(), netip.Addr.Is4In6},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !tc.ok(tc.addr) {
+				t.Errorf("Expected %s to match its category", tc.addr)
+			}
+		})
+	}
+
+	public := []netip.Addr{
+		IPv4TestNet1Addr(), IPv4TestNet2Addr(), IPv4TestNet3Addr(), IPv4CGNATAddr(), IPv6DocumentationAddr(),
+	}
+	for _, a := range public {
+		if a.IsPrivate() || a.IsLoopback() || a.IsLinkLocalUnicast() {
+			t.Errorf("Expected %s not to be private, loopback, or link-local", a)
+		}
+	}
+	if !IPv4Broadcast().Equal(net.IPv4bcast) {
+		t.Errorf("Expected broadcast to equal net.IPv4bcast, got %s", IPv4Broadcast())
+	}
+}
+
+func TestIPv4Mapped(t *testing.T) {
+	t.Parallel()
+	if IPv4Mapped().To4() == nil || IPv4Mapped().String() != IPv4TestNet1String {
+		t.Errorf("Expected net.IP to collapse to %s, got %s", IPv4TestNet1String, IPv4Mapped())
+	}
+	if IPv4MappedAddr().Unmap() != IPv4TestNet1Addr() {
+		t.Errorf("Expected Unmap to give %s, got %s", IPv4TestNet1Addr(), IPv4MappedAddr().Unmap())
+	}
+	if IPv4MappedAddr() == IPv4TestNet1Addr() {
+		t.Error("Expected netip.Addr to keep the mapped form distinct")
+	}
+}
@@ -0,0 +1,71 @@
+package testjson
+
+import (
+	"io"
+	"strconv"
+)
+
+// LargeArray returns a reader that yields a JSON array of the integers 0
+// through n-1, generated as it is read, so arrays far larger than memory can
+// be fed to a decoder.
+func LargeArray(n int64) io.Reader {
+	return &arrayReader{n: n, closing: true}
+}
+
+// LargeArrayTruncated returns a reader like LargeArray that stops before the
+// closing bracket. A json.Decoder reading it fails with io.ErrUnexpectedEOF
+// only after consuming every element.
+func LargeArrayTruncated(n int64) io.Reader {
+	return &arrayReader{n: n}
+}
+
+// arrayReader generates "[0,1,...,n-1]" one element at a time.
+type arrayReader struct {
+	n       int64
+	next    int64
+	started bool
+	closing bool
+	done    bool
+	pending []byte
+	buf     []byte
+}
+
+func (r *arrayReader) Read(p []byte) (int, error) {
+	total := 0
+	for total < len(p) {
+		if len(r.pending) == 0 && !r.fill() {
+			if total == 0 {
+				return 0, io.EOF
+			}
+			break
+		}
+		n := copy(p[total:], r.pending)
+		r.pending = r.pending[n:]
+		total += n
+	}
+	return total, nil
+}
+
+// fill queues the next piece of the array and reports whether there was
+// one.
+func (r *arrayReader) fill() bool {
+	r.buf = r.buf[:0]
+	switch {
+	case !r.started:
+		r.started = true
+		r.buf = append(r.buf, '[')
+	case r.next < r.n:
+		if r.next > 0 {
+			r.buf = append(r.buf, ',')
+		}
+		r.buf = strconv.AppendInt(r.buf, r.next, 10)
+		r.next++
+	case r.closing && !r.done:
+		r.done = true
+		r.buf = append(r.buf, ']')
+	default:
+		return false
+	}
+	r.pending = r.buf
+	return true
+}
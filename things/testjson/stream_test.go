@@ -0,0 +1,74 @@
+package testjson
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLargeArray(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		n    int64
+		want string
+	}{
+		{"Empty", 0, "[]"},
+		{"One", 1, "[0]"},
+		{"Several", 12, "[0,1,2,3,4,5,6,7,8,9,10,11]"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if err := iotest.TestReader(LargeArray(tc.n), []byte(tc.want)); err != nil {
+				t.Errorf("Expected %q: %v", tc.want, err)
+			}
+			got, err := io.ReadAll(LargeArrayTruncated(tc.n))
+			if err != nil || string(got) != tc.want[:len(tc.want)-1] {
+				t.Errorf("Expected truncated %q, got %q (%v)", tc.want[:len(tc.want)-1], got, err)
+			}
+		})
+	}
+}
+
+func TestLargeArrayDecodes(t *testing.T) {
+	t.Parallel()
+	const n = 100000
+
+	var got []int64
+	if err := json.NewDecoder(LargeArray(n)).Decode(&got); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(got) != n || got[n-1] != n-1 {
+		t.Errorf("Expected %d elements ending in %d, got %d", n, n-1, len(got))
+	}
+}
+
+func TestLargeArrayTruncatedDecodes(t *testing.T) {
+	t.Parallel()
+	var v []int64
+	err := json.NewDecoder(LargeArrayTruncated(1000)).Decode(&v)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestLargeArrayStreams(t *testing.T) {
+	t.Parallel()
+	// An array this size would not fit in memory, so reading its start shows
+	// it is generated lazily.
+	const n = 1 << 50
+	dec := json.NewDecoder(LargeArray(n))
+	if _, err := dec.Token(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i := range 1000 {
+		var v int
+		if err := dec.Decode(&v); err != nil || v != i {
+			t.Fatalf("Expected element %d, got %d (%v)", i, v, err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Package testjson provides malformed and adversarial JSON payloads for
+decoder error paths, each with the encoding/json error it is expected to
+produce.
+
+	github.com/madflojo/testlazy/things/testjson
+
+The corpus covers truncated documents, trailing commas, duplicate keys,
+nesting bombs, huge numbers, NaN and Infinity, invalid UTF-8 and escapes,
+byte order marks, top-level scalars, and type mismatches. Some entries are
+accepted by encoding/json, such as duplicate keys, and are there because
+other decoders, or the code behind them, may disagree.
+
+Example usage
+
+	for _, p := range testjson.Payloads() {
+	    t.Run(p.Name, func(t *testing.T) {
+	        rec := httptest.NewRecorder()
+
+
+	        handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(p.Data)))
+	        // ...
+	    })
+	}
+
+LargeArray streams an array of any size through an io.Reader without
+building it in memory.
+*/
+package testjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxDepth is the deepest nesting encoding/json decodes. One level more
+// fails with "exceeded max depth".
+const MaxDepth = 10000
+
+// ErrorType names the encoding/json error type a payload produces.
+type ErrorType string
+
+// Error types produced by the corpus.
+const (
+	// NoError means encoding/json decodes the payload without error.
+	NoError ErrorType = ""
+
+	// SyntaxError means a *json.SyntaxError.
+	SyntaxError ErrorType = "*json.SyntaxError"
+
+	// UnmarshalTypeError means a *json.UnmarshalTypeError.
+	UnmarshalTypeError ErrorType = "*json.UnmarshalTypeError"
+)
+
+// Matches reports whether err is of type e. NoError matches only a nil err.
+func (e ErrorType) Matches(err error) bool {
+	switch e {
+	case NoError:
+		return err == nil
+	case SyntaxError:
+		var target *json.SyntaxError
+		return errors.As(err, &target)
+	case UnmarshalTypeError:
+		var target *json.UnmarshalTypeError
+		return errors.As(err, &target)
+	}
+	return false
+}
+
+// Payload is a single JSON document of the corpus.
+type Payload struct {
+	// Name briefly describes what is wrong with Data.
+	Name string
+
+	// Data is the raw document.
+	Data []byte
+
+	// Target is a pointer to decode Data into. Nil means a fresh *any.
+	Target any
+
+	// Err is the type of error json.Unmarshal returns for Data and Target.
+	Err ErrorType
+}
+
+// Payloads returns a fresh copy of the payload corpus. Unless noted, each is
+// decoded into *any:
+//
+//	truncated object          {"a":1                      SyntaxError
+//	truncated value           {"a":                       SyntaxError
+//	truncated array           [1,2                        SyntaxError
+//	unterminated string       "abc                        SyntaxError
+//	empty document                                        SyntaxError
+//	whitespace only                                       SyntaxError
+//	trailing comma in object  {"a":1,}                    SyntaxError
+//	trailing comma in array   [1,2,]                      SyntaxError
+//	single quotes             {'a':1}                     SyntaxError
+//	unquoted key              {a:1}                       SyntaxError
+//	comment                   /* c */ {}                  SyntaxError
+//	leading zero              [01]                        SyntaxError
+//	leading dot               [.5]                        SyntaxError
+//	leading plus              [+1]                        SyntaxError
+//	NaN                       [NaN]                       SyntaxError
+//	Infinity                  [Infinity]                  SyntaxError
+//	negative Infinity         [-Infinity]                 SyntaxError
+//	invalid escape            ["\x"]                      SyntaxError
+//	raw control character     ["a<TAB>b"]                 SyntaxError
+//	byte order mark           <BOM>{}                     SyntaxError
+//	two documents             {} {}                       SyntaxError
+//	past max depth            MaxDepth+1 nested arrays    SyntaxError
+//	huge number               1e400                       UnmarshalTypeError
+//	huge integer into int64   30-digit integer            UnmarshalTypeError
+//	negative into uint        -1                          UnmarshalTypeError
+//	overflow uint8            300                         UnmarshalTypeError
+//	fraction into int         1.5                         UnmarshalTypeError
+//	string into int           "str"                       UnmarshalTypeError
+//	array into map            [1]                         UnmarshalTypeError
+//	wrong field type          {"a":"x"}                   UnmarshalTypeError
+//	duplicate keys            {"a":1,"a":2}               no error, last wins
+//	invalid UTF-8 in string   ["<0xFF>"]                  no error, becomes U+FFFD
+//	lone surrogate escape     ["\ud800"]                  no error, becomes U+FFFD
+//	huge integer into any     30-digit integer            no error, loses precision
+//	at max depth              MaxDepth nested arrays      no error
+//	top-level number          42                          no error
+//	top-level string          "str"                       no error
+//	top-level null            null                        no error
+//	top-level boolean         true                        no error
+func Payloads() []Payload {
+	return []Payload{
+		{Name: "truncated object", Data: []byte(`{"a":1`), Err: SyntaxError},
+		{Name: "truncated value", Data: []byte(`{"a":`), Err: SyntaxError},
+		{Name: "truncated array", Data: []byte(`[1,2`), Err: SyntaxError},
+		{Name: "unterminated string", Data: []byte(`"abc`), Err: SyntaxError},
+		{Name: "empty document", Data: []byte(``), Err: SyntaxError},
+		{Name: "whitespace only", Data: []byte(" \t\n"), Err: SyntaxError},
+		{Name: "trailing comma in object", Data: []byte(`{"a":1,}`), Err: SyntaxError},
+		{Name: "trailing comma in array", Data: []byte(`[1,2,]`), Err: SyntaxError},
+		{Name: "single quotes", Data: []byte(`{'a':1}`), Err: SyntaxError},
+		{Name: "unquoted key", Data: []byte(`{a:1}`), Err: SyntaxError},
+		{Name: "comment", Data: []byte(`/* c */ {}`), Err: SyntaxError},
+		{Name: "leading zero", Data: []byte(`[01]`), Err: SyntaxError},
+		{Name: "leading dot", Data: []byte(`[.5]`), Err: SyntaxError},
+		{Name: "leading plus", Data: []byte(`[+1]`), Err: SyntaxError},
+		{Name: "NaN", Data: []byte(`[NaN]`), Err: SyntaxError},
+		{Name: "Infinity", Data: []byte(`[Infinity]`), Err: SyntaxError},
+		{Name: "negative Infinity", Data: []byte(`[-Infinity]`), Err: SyntaxError},
+		{Name: "invalid escape", Data: []byte(`["\x"]`), Err: SyntaxError},
+		{Name: "raw control character", Data: []byte("[\"a\tb\"]"), Err: SyntaxError},
+		{Name: "byte order mark", Data: []byte("\xef\xbb\xbf{}"), Err: SyntaxError},
+		{Name: "two documents", Data: []byte(`{} {}`), Err: SyntaxError},
+		{Name: "past max depth", Data: DeepNesting(MaxDepth + 1), Err: SyntaxError},
+		{Name: "huge number", Data: []byte(`1e400`), Err: UnmarshalTypeError},
+		{
+			Name:   "huge integer into int64",
+			Data:   []byte(`123456789012345678901234567890`),
+			Target: new(int64),
+			Err:    UnmarshalTypeError,
+		},
+		{
+			Name:   "negative into uint",
+			Data:   []byte(`-1`),
+			Target: new(uint),
+			Err:    UnmarshalTypeError,
+		},
+		{Name: "overflow uint8", Data: []byte(`300`), Target: new(uint8), Err: UnmarshalTypeError},
+		{Name: "fraction into int", Data: []byte(`1.5`), Target: new(int), Err: UnmarshalTypeError},
+		{Name: "string into int", Data: []byte(`"str"`), Target: new(int), Err: UnmarshalTypeError},
+		{
+			Name:   "array into map",
+			Data:   []byte(`[1]`),
+			Target: new(map[string]int),
+			Err:    UnmarshalTypeError,
+		},
+		{
+			Name:   "wrong field type",
+			Data:   []byte(`{"a":"x"}`),
+			Target: new(struct{ A int }),
+			Err:    UnmarshalTypeError,
+		},
+		{Name: "duplicate keys", Data: []byte(`{"a":1,"a":2}`), Err: NoError},
+		{Name: "invalid UTF-8 in string", Data: []byte("[\"\xff\"]"), Err: NoError},
+		{Name: "lone surrogate escape", Data: []byte(`["\ud800"]`), Err: NoError},
+		{
+			Name: "huge integer into any",
+			Data: []byte(`123456789012345678901234567890`),
+			Err:  NoError,
+		},
+		{Name: "at max depth", Data: DeepNesting(MaxDepth), Err: NoError},
+		{Name: "top-level number", Data: []byte(`42`), Err: NoError},
+		{Name: "top-level string", Data: []byte(`"str"`), Err: NoError},
+		{Name: "top-level null", Data: []byte(`null`), Err: NoError},
+		{Name: "top-level boolean", Data: []byte(`true`), Err: NoError},
+	}
+}
+
+// DeepNesting returns depth nested empty arrays, such as "[[[]]]" for a depth
+// of 3. Recursive decoders without a depth limit overflow their stack on a
+// large depth.
+func DeepNesting(depth int) []byte {
+	return []byte(strings.Repeat("[", depth) + strings.Repeat("]", depth))
+}
+
+// MustMatchUnmarshal is a helper function that runs json.Unmarshal on p.Data
+// into p.Target and panics unless the error is of type p.Err. It returns the
+// error.
+func MustMatchUnmarshal(p Payload) error {
+	target := p.Target
+	if target == nil {
+		target = new(any)
+	}
+
+	err := json.Unmarshal(p.Data, target)
+	if !p.Err.Matches(err) {
+		want := string(p.Err)
+		if p.Err == NoError {
+			want = "no error"
+		}
+		panic(
+			fmt.Sprintf(
+				"expected json.Unmarshal of %.40q to return %s, got %T: %v",
+				p.Data,
+				want,
+				err,
+				err,
+			),
+		)
+	}
+	return err
+}
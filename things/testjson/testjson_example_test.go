@@ -0,0 +1,33 @@
+package testjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func ExamplePayloads() {
+	for _, p := range Payloads()[:3] {
+		var v any
+		err := json.Unmarshal(p.Data, &v)
+		fmt.Println(p.Name, p.Err.Matches(err))
+	}
+	// Output:
+	// truncated object true
+	// truncated value true
+	// truncated array true
+}
+
+func ExampleLargeArray() {
+	b, _ := io.ReadAll(LargeArray(5))
+	fmt.Println(string(b))
+	// Output: [0,1,2,3,4]
+}
+
+func ExampleDeepNesting() {
+	var v any
+	err := json.Unmarshal(DeepNesting(MaxDepth+1), &v)
+	fmt.Println(strings.Contains(err.Error(), "exceeded max depth"))
+	// Output: true
+}
@@ -0,0 +1,119 @@
+package testjson
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestPayloads(t *testing.T) {
+	t.Parallel()
+
+	entries := Payloads()
+	if len(entries) == 0 {
+		t.Fatal("Expected a non-empty corpus")
+	}
+
+	seen := make(map[string]bool)
+	for _, tc := range entries {
+		if seen[tc.Name] {
+			t.Fatalf("Duplicate entry name %q", tc.Name)
+		}
+		seen[tc.Name] = true
+
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("Expected Err to match encoding/json: %v", r)
+				}
+			}()
+			if err := MustMatchUnmarshal(tc); (err == nil) != (tc.Err == NoError) {
+				t.Errorf("Expected MustMatchUnmarshal to return the error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPayloadsReturnsCopy(t *testing.T) {
+	t.Parallel()
+	first := Payloads()
+	first[0].Data[0] = 'x'
+	if Payloads()[0].Data[0] == 'x' {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
+
+func TestErrorTypeMatches(t *testing.T) {
+	t.Parallel()
+	syntax := &json.SyntaxError{}
+	unmarshal := &json.UnmarshalTypeError{}
+	tests := []struct {
+		name string
+		typ  ErrorType
+		err  error
+		want bool
+	}{
+		{"NoError nil", NoError, nil, true},
+		{"NoError error", NoError, syntax, false},
+		{"SyntaxError", SyntaxError, syntax, true},
+		{"SyntaxError wrapped", SyntaxError, errors.Join(errors.New("decode"), syntax), true},
+		{"SyntaxError mismatch", SyntaxError, unmarshal, false},
+		{"SyntaxError nil", SyntaxError, nil, false},
+		{"UnmarshalTypeError", UnmarshalTypeError, unmarshal, true},
+		{"UnmarshalTypeError mismatch", UnmarshalTypeError, io.EOF, false},
+		{"Unknown type", ErrorType("*json.Other"), syntax, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.typ.Matches(tc.err); got != tc.want {
+				t.Errorf("Expected Matches to be %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMustMatchUnmarshal(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name  string
+		entry Payload
+		panic bool
+	}{
+		{"Matching syntax error", Payload{Data: []byte(`{`), Err: SyntaxError}, false},
+		{"Matching success", Payload{Data: []byte(`{}`)}, false},
+		{
+			"Matching typed target",
+			Payload{Data: []byte(`"x"`), Target: new(int), Err: UnmarshalTypeError},
+			false,
+		},
+		{"Unexpected success", Payload{Data: []byte(`{}`), Err: SyntaxError}, true},
+		{"Unexpected error", Payload{Data: []byte(`{`)}, true},
+		{"Wrong error type", Payload{Data: []byte(`{`), Err: UnmarshalTypeError}, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); (r != nil) != tc.panic {
+					t.Errorf("Expected panic to be %t, got %v", tc.panic, r)
+				}
+			}()
+			MustMatchUnmarshal(tc.entry)
+		})
+	}
+}
+
+func TestDeepNesting(t *testing.T) {
+	t.Parallel()
+	if got := string(DeepNesting(3)); got != "[[[]]]" {
+		t.Errorf("Expected %q, got %q", "[[[]]]", got)
+	}
+	if got := DeepNesting(0); len(got) != 0 {
+		t.Errorf("Expected an empty document, got %q", got)
+	}
+}
@@ -0,0 +1,495 @@
+/*
+Package teststrings provides a corpus of naughty strings: inputs that are
+valid Go strings but tend to break the code that handles them, grouped by
+category and tagged so a test can pick the ones that matter.
+
+	github.com/madflojo/testlazy/things/teststrings
+
+It is inspired by the Big List of Naughty Strings and covers empty and
+whitespace-only input, very long strings, bidirectional overrides,
+zero-width and combining characters, emoji sequences, invalid UTF-8,
+injection-shaped strings, format verbs, and reserved file names.
+
+Example usage
+
+	for _, s := range teststrings.ByCategory(teststrings.Bidi) {
+	    t.Run(s.Name, func(t *testing.T) {
+	        if got := sanitize(s.Value); strings.ContainsRune(got, '\u202e') {
+	            t.Errorf("override survived: %q", got)
+	        }
+	    })
+	}
+
+Fuzz targets can start from the corpus with SeedCorpus.
+
+The injection strings are inert payload shapes for checking escaping. They
+are not meant to be run against systems you do not own.
+*/
+package teststrings
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Category groups strings by what makes them naughty. Every String has
+// exactly one.
+type Category string
+
+// Categories of the corpus.
+const (
+	Empty            Category = "empty"
+	Whitespace       Category = "whitespace"
+	Long             Category = "long"
+	Bidi             Category = "bidi"
+	ZeroWidth        Category = "zero-width"
+	Combining        Category = "combining"
+	Emoji            Category = "emoji"
+	InvalidUTF8      Category = "invalid-utf8"
+	SQLInjection     Category = "sql-injection"
+	HTMLInjection    Category = "html-injection"
+	ShellInjection   Category = "shell-injection"
+	FormatVerbs      Category = "format-verbs"
+	ReservedFilename Category = "reserved-filename"
+)
+
+// Tag marks a property a String has, across categories. A String may have
+// any number of tags.
+type Tag string
+
+// Tags used in the corpus.
+const (
+	// Invisible strings render as nothing or as less than they contain.
+	Invisible Tag = "invisible"
+
+	// Unicode strings contain characters outside ASCII.
+	Unicode Tag = "unicode"
+
+	// NotUTF8 strings fail utf8.ValidString.
+	NotUTF8 Tag = "not-utf8"
+
+	// ControlChars strings contain ASCII or Unicode control characters.
+	ControlChars Tag = "control-chars"
+
+	// Injection strings are shaped to escape from a quoted or templated
+	// context.
+	Injection Tag = "injection"
+
+	// Windows strings are special on Windows file systems.
+	Windows Tag = "windows"
+
+	// Path strings are special in file paths on any system.
+	Path Tag = "path"
+
+	// Large strings are at least 64KiB long.
+	Large Tag = "large"
+)
+
+// LongLength is the length in bytes of the ASCII strings in the Long
+// category, one byte over 64KiB.
+const LongLength = 64<<10 + 1
+
+// String is a single entry of the corpus.
+type String struct {
+	// Name briefly describes Value.
+	Name string
+
+	// Value is the string itself.
+	Value string
+
+	// Category is the group Value belongs to.
+	Category Category
+
+	// Tags lists further properties of Value.
+	Tags []Tag
+}
+
+// HasTag reports whether s carries tag.
+func (s String) HasTag(tag Tag) bool {
+	return slices.Contains(s.Tags, tag)
+}
+
+// All returns a fresh copy of the whole corpus, in category order.
+func All() []String {
+	return corpus()
+}
+
+// ByCategory returns the strings in any of the given categories.
+func ByCategory(categories ...Category) []String {
+	var out []String
+	for _, s := range corpus() {
+		if slices.Contains(categories, s.Category) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// WithTag returns the strings that carry tag.
+func WithTag(tag Tag) []String {
+	var out []String
+	for _, s := range corpus() {
+		if s.HasTag(tag) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Values returns the Value of each string in strs.
+func Values(strs []String) []string {
+	out := make([]string, len(strs))
+	for i, s := range strs {
+		out[i] = s.Value
+	}
+	return out
+}
+
+// SeedCorpus adds the strings in the given categories, or the whole corpus
+// when none are given, to the fuzz corpus of f, each as a single string
+// argument. Use it with fuzz targets of the form func(t *testing.T, s string).
+func SeedCorpus(f *testing.F, categories ...Category) {
+	f.Helper()
+
+	strs := All()
+	if len(categories) > 0 {
+		strs = ByCategory(categories...)
+	}
+	for _, s := range strs {
+		f.Add(s.Value)
+	}
+}
+
+// corpus builds the corpus. The long strings are built on every call so each
+// caller gets its own copy of the slice.
+func corpus() []String {
+	return []String{
+		{Name: "empty string", Value: "", Category: Empty, Tags: []Tag{Invisible}},
+		{Name: "null byte", Value: "\x00", Category: Empty, Tags: []Tag{Invisible, ControlChars}},
+
+		{Name: "single space", Value: " ", Category: Whitespace, Tags: []Tag{Invisible}},
+		{Name: "tab", Value: "\t", Category: Whitespace, Tags: []Tag{Invisible, ControlChars}},
+		{
+			Name:     "newlines",
+			Value:    "\n\r\n",
+			Category: Whitespace,
+			Tags:     []Tag{Invisible, ControlChars},
+		},
+		{
+			Name:     "mixed ASCII whitespace",
+			Value:    " \t\n\v\f\r ",
+			Category: Whitespace,
+			Tags:     []Tag{Invisible, ControlChars},
+		},
+		{
+			Name:     "no-break space",
+			Value:    "\u00a0",
+			Category: Whitespace,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "ideographic space",
+			Value:    "\u3000",
+			Category: Whitespace,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "line separator",
+			Value:    "\u2028",
+			Category: Whitespace,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "paragraph separator",
+			Value:    "\u2029",
+			Category: Whitespace,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{Name: "surrounding spaces", Value: "  padded  ", Category: Whitespace},
+
+		{
+			Name:     "long ASCII",
+			Value:    strings.Repeat("A", LongLength),
+			Category: Long,
+			Tags:     []Tag{Large},
+		},
+		{
+			Name:     "long single line of words",
+			Value:    strings.Repeat("lazy ", LongLength/5+1),
+			Category: Long,
+			Tags:     []Tag{Large},
+		},
+		{
+			Name:     "long multibyte",
+			Value:    strings.Repeat("日本", LongLength/6+1),
+			Category: Long,
+			Tags:     []Tag{Large, Unicode},
+		},
+
+		{
+			Name:     "right-to-left override",
+			Value:    "invoice\u202egpj.exe",
+			Category: Bidi,
+			Tags:     []Tag{Unicode, ControlChars},
+		},
+		{
+			Name:     "left-to-right override",
+			Value:    "\u202dabc\u202c",
+			Category: Bidi,
+			Tags:     []Tag{Unicode, ControlChars},
+		},
+		{
+			Name:     "right-to-left isolate",
+			Value:    "\u2067user\u2069",
+			Category: Bidi,
+			Tags:     []Tag{Unicode, ControlChars},
+		},
+		{Name: "Arabic with Latin", Value: "مرحبا hello", Category: Bidi, Tags: []Tag{Unicode}},
+		{Name: "Hebrew", Value: "שלום", Category: Bidi, Tags: []Tag{Unicode}},
+
+		{
+			Name:     "zero-width space",
+			Value:    "a\u200bb",
+			Category: ZeroWidth,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "zero-width joiner",
+			Value:    "a\u200db",
+			Category: ZeroWidth,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "zero-width non-joiner",
+			Value:    "a\u200cb",
+			Category: ZeroWidth,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "byte order mark",
+			Value:    "\ufeffadmin",
+			Category: ZeroWidth,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "word joiner",
+			Value:    "ad\u2060min",
+			Category: ZeroWidth,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+		{
+			Name:     "soft hyphen",
+			Value:    "ad\u00admin",
+			Category: ZeroWidth,
+			Tags:     []Tag{Invisible, Unicode},
+		},
+
+		{Name: "decomposed e acute", Value: "e\u0301", Category: Combining, Tags: []Tag{Unicode}},
+		{Name: "precomposed e acute", Value: "é", Category: Combining, Tags: []Tag{Unicode}},
+		{
+			Name:     "stacked marks",
+			Value:    "a\u0300\u0301\u0302\u0303\u0304",
+			Category: Combining,
+			Tags:     []Tag{Unicode},
+		},
+		{
+			Name:     "zalgo",
+			Value:    "Z" + strings.Repeat("\u0336", 1000),
+			Category: Combining,
+			Tags:     []Tag{Unicode},
+		},
+		{
+			Name:     "leading combining mark",
+			Value:    "\u0301abc",
+			Category: Combining,
+			Tags:     []Tag{Unicode},
+		},
+		{Name: "Devanagari cluster", Value: "क्षि", Category: Combining, Tags: []Tag{Unicode}},
+
+		{Name: "single emoji", Value: "😀", Category: Emoji, Tags: []Tag{Unicode}},
+		{
+			Name:     "family ZWJ sequence",
+			Value:    "👨\u200d👩\u200d👧\u200d👦",
+			Category: Emoji,
+			Tags:     []Tag{Unicode},
+		},
+		{Name: "skin tone modifier", Value: "👍🏽", Category: Emoji, Tags: []Tag{Unicode}},
+		{Name: "regional indicator flag", Value: "🇳🇿", Category: Emoji, Tags: []Tag{Unicode}},
+		{Name: "keycap sequence", Value: "1\ufe0f\u20e3", Category: Emoji, Tags: []Tag{Unicode}},
+		{Name: "variation selector", Value: "❤\ufe0f", Category: Emoji, Tags: []Tag{Unicode}},
+
+		{
+			Name:     "lone continuation byte",
+			Value:    "\x80",
+			Category: InvalidUTF8,
+			Tags:     []Tag{NotUTF8},
+		},
+		{
+			Name:     "truncated sequence",
+			Value:    "abc\xe2\x82",
+			Category: InvalidUTF8,
+			Tags:     []Tag{NotUTF8},
+		},
+		{
+			Name:     "overlong slash",
+			Value:    "\xc0\xaf",
+			Category: InvalidUTF8,
+			Tags:     []Tag{NotUTF8, Path},
+		},
+		{
+			Name:     "UTF-16 surrogate",
+			Value:    "\xed\xa0\x80",
+			Category: InvalidUTF8,
+			Tags:     []Tag{NotUTF8},
+		},
+		{Name: "invalid byte 0xFF", Value: "\xff\xfe", Category: InvalidUTF8, Tags: []Tag{NotUTF8}},
+
+		{
+			Name:     "quote and comment",
+			Value:    "' OR '1'='1' --",
+			Category: SQLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "stacked query",
+			Value:    "1; DROP TABLE users; --",
+			Category: SQLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "union select",
+			Value:    "' UNION SELECT NULL, NULL --",
+			Category: SQLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{Name: "double quote", Value: `" OR ""="`, Category: SQLInjection, Tags: []Tag{Injection}},
+		{Name: "backslash escape", Value: `\'; --`, Category: SQLInjection, Tags: []Tag{Injection}},
+
+		{
+			Name:     "script tag",
+			Value:    "<script>alert(1)</script>",
+			Category: HTMLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "attribute break",
+			Value:    `"><img src=x onerror=alert(1)>`,
+			Category: HTMLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "javascript URL",
+			Value:    "javascript:alert(1)",
+			Category: HTMLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "closing textarea",
+			Value:    "</textarea><b>bold</b>",
+			Category: HTMLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "entity encoded",
+			Value:    "&lt;script&gt;",
+			Category: HTMLInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "template braces",
+			Value:    "{{.}}${7*7}",
+			Category: HTMLInjection,
+			Tags:     []Tag{Injection},
+		},
+
+		{
+			Name:     "command separator",
+			Value:    "; echo injected",
+			Category: ShellInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "command substitution",
+			Value:    "$(echo injected)",
+			Category: ShellInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "backticks",
+			Value:    "`echo injected`",
+			Category: ShellInjection,
+			Tags:     []Tag{Injection},
+		},
+		{Name: "pipe", Value: "| echo injected", Category: ShellInjection, Tags: []Tag{Injection}},
+		{
+			Name:     "and chain",
+			Value:    "&& echo injected",
+			Category: ShellInjection,
+			Tags:     []Tag{Injection},
+		},
+		{
+			Name:     "newline separator",
+			Value:    "x\necho injected",
+			Category: ShellInjection,
+			Tags:     []Tag{Injection, ControlChars},
+		},
+		{Name: "leading dash", Value: "--help", Category: ShellInjection, Tags: []Tag{Injection}},
+
+		{Name: "Go verbs", Value: "%s%v%d%x", Category: FormatVerbs},
+		{Name: "C verbs", Value: "%s%s%s%s%n", Category: FormatVerbs},
+		{Name: "positional verb", Value: "%[2]*[1]d", Category: FormatVerbs},
+		{Name: "width overflow", Value: "%99999999999s", Category: FormatVerbs},
+		{Name: "lone percent", Value: "100%", Category: FormatVerbs},
+		{
+			Name:     "percent-encoded",
+			Value:    "%00%0a%2e%2e%2f",
+			Category: FormatVerbs,
+			Tags:     []Tag{Path},
+		},
+
+		{Name: "CON", Value: "CON", Category: ReservedFilename, Tags: []Tag{Windows}},
+		{Name: "NUL", Value: "NUL", Category: ReservedFilename, Tags: []Tag{Windows}},
+		{
+			Name:     "COM1 with extension",
+			Value:    "com1.txt",
+			Category: ReservedFilename,
+			Tags:     []Tag{Windows},
+		},
+		{Name: "LPT9", Value: "LPT9", Category: ReservedFilename, Tags: []Tag{Windows}},
+		{Name: "trailing dot", Value: "file.", Category: ReservedFilename, Tags: []Tag{Windows}},
+		{Name: "trailing space", Value: "file ", Category: ReservedFilename, Tags: []Tag{Windows}},
+		{
+			Name:     "forbidden characters",
+			Value:    `a<b>c:d"e|f?g*h`,
+			Category: ReservedFilename,
+			Tags:     []Tag{Windows},
+		},
+		{Name: "dot", Value: ".", Category: ReservedFilename, Tags: []Tag{Path}},
+		{Name: "dot dot", Value: "..", Category: ReservedFilename, Tags: []Tag{Path}},
+		{
+			Name:     "traversal",
+			Value:    "../../etc/passwd",
+			Category: ReservedFilename,
+			Tags:     []Tag{Path},
+		},
+		{
+			Name:     "Windows traversal",
+			Value:    `..\..\windows\win.ini`,
+			Category: ReservedFilename,
+			Tags:     []Tag{Path, Windows},
+		},
+		{
+			Name:     "embedded null",
+			Value:    "file.txt\x00.png",
+			Category: ReservedFilename,
+			Tags:     []Tag{Path, ControlChars},
+		},
+		{
+			Name:     "alternate data stream",
+			Value:    "file.txt::$DATA",
+			Category: ReservedFilename,
+			Tags:     []Tag{Windows},
+		},
+	}
+}
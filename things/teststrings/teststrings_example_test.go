@@ -0,0 +1,31 @@
+package teststrings
+
+import (
+	"fmt"
+	"html"
+)
+
+func ExampleByCategory() {
+	for _, s := range ByCategory(HTMLInjection)[:2] {
+		fmt.Println(html.EscapeString(s.Value))
+	}
+	// Output:
+	// &lt;script&gt;alert(1)&lt;/script&gt;
+	// &#34;&gt;&lt;img src=x onerror=alert(1)&gt;
+}
+
+func ExampleWithTag() {
+	for _, s := range WithTag(Windows)[:3] {
+		fmt.Println(s.Value)
+	}
+	// Output:
+	// CON
+	// NUL
+	// com1.txt
+}
+
+func ExampleString_HasTag() {
+	s := ByCategory(Bidi)[0]
+	fmt.Println(s.Name, s.HasTag(ControlChars))
+	// Output: right-to-left override true
+}
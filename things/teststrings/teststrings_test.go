@@ -0,0 +1,162 @@
+package teststrings
+
+import (
+	"html"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var categories = []Category{
+	Empty, Whitespace, Long, Bidi, ZeroWidth, Combining, Emoji, InvalidUTF8,
+	SQLInjection, HTMLInjection, ShellInjection, FormatVerbs, ReservedFilename,
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[string]bool)
+	for _, s := range All() {
+		if seen[s.Name] {
+			t.Fatalf("Duplicate entry name %q", s.Name)
+		}
+		seen[s.Name] = true
+
+		t.Run(s.Name, func(t *testing.T) {
+			t.Parallel()
+			if !strings.Contains(categoryList(), "|"+string(s.Category)+"|") {
+				t.Errorf("Expected a known category, got %q", s.Category)
+			}
+			if valid := utf8.ValidString(s.Value); valid == s.HasTag(NotUTF8) {
+				t.Errorf("Expected NotUTF8 tag to be %t", !valid)
+			}
+			if large := len(s.Value) >= 64<<10; large != s.HasTag(Large) {
+				t.Errorf("Expected Large tag to be %t, length is %d", large, len(s.Value))
+			}
+			if s.HasTag(Unicode) && isASCII(s.Value) {
+				t.Errorf("Expected non-ASCII characters in %q", s.Value)
+			}
+			if s.Category == Whitespace && !s.HasTag(Invisible) &&
+				strings.TrimSpace(s.Value) == s.Value {
+				t.Errorf("Expected whitespace to trim from %q", s.Value)
+			}
+		})
+	}
+}
+
+func TestAllReturnsCopy(t *testing.T) {
+	t.Parallel()
+	first := All()
+	first[0].Value = "changed"
+	first[1].Tags[0] = "changed"
+	if All()[0].Value == "changed" || All()[1].Tags[0] == "changed" {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
+
+func TestByCategory(t *testing.T) {
+	t.Parallel()
+	total := 0
+	for _, c := range categories {
+		got := ByCategory(c)
+		if len(got) == 0 {
+			t.Errorf("Expected entries in category %q", c)
+		}
+		for _, s := range got {
+			if s.Category != c {
+				t.Errorf("Expected category %q, got %q", c, s.Category)
+			}
+		}
+		total += len(got)
+	}
+	if total != len(All()) {
+		t.Errorf("Expected categories to cover %d entries, got %d", len(All()), total)
+	}
+
+	both := ByCategory(Empty, Emoji)
+	if len(both) != len(ByCategory(Empty))+len(ByCategory(Emoji)) {
+		t.Errorf("Expected the union of two categories, got %d entries", len(both))
+	}
+	if got := ByCategory(); len(got) != 0 {
+		t.Errorf("Expected no entries without categories, got %d", len(got))
+	}
+}
+
+func TestWithTag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		tag  Tag
+		want string
+	}{
+		{Invisible, "zero-width space"},
+		{Unicode, "family ZWJ sequence"},
+		{NotUTF8, "lone continuation byte"},
+		{ControlChars, "right-to-left override"},
+		{Injection, "script tag"},
+		{Windows, "CON"},
+		{Path, "traversal"},
+		{Large, "long ASCII"},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			t.Parallel()
+			var names []string
+			for _, s := range WithTag(tc.tag) {
+				if !s.HasTag(tc.tag) {
+					t.Errorf("Expected %q to carry %q", s.Name, tc.tag)
+				}
+				names = append(names, s.Name)
+			}
+			if !strings.Contains("|"+strings.Join(names, "|")+"|", "|"+tc.want+"|") {
+				t.Errorf("Expected %q among %q", tc.want, names)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+	got := Values(ByCategory(Empty))
+	if len(got) != 2 || got[0] != "" || got[1] != "\x00" {
+		t.Errorf("Expected the empty values, got %q", got)
+	}
+}
+
+func FuzzSeedCorpus(f *testing.F) {
+	SeedCorpus(f)
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if escaped := html.EscapeString(s); strings.ContainsAny(escaped, "<>\"'") {
+			t.Errorf("Expected html.EscapeString(%q) to escape markup, got %q", s, escaped)
+		}
+	})
+}
+
+func FuzzSeedCorpusCategories(f *testing.F) {
+	SeedCorpus(f, InvalidUTF8, Emoji)
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(strings.ToValidUTF8(s, "\uFFFD")) {
+			t.Errorf("Expected ToValidUTF8(%q) to be valid", s)
+		}
+	})
+}
+
+// categoryList returns the known categories joined and wrapped in "|".
+func categoryList() string {
+	list := make([]string, len(categories))
+	for i, c := range categories {
+		list[i] = string(c)
+	}
+	return "|" + strings.Join(list, "|") + "|"
+}
+
+func isASCII(s string) bool {
+	for i := range len(s) {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
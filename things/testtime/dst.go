@@ -0,0 +1,90 @@
+package testtime
+
+import (
+	"fmt"
+	"time"
+
+	// Embed the time zone database so the transitions do not depend on the
+	// host.
+	_ "time/tzdata"
+)
+
+// Locations used by Transitions.
+const (
+	NewYork = "America/New_York"
+	London  = "Europe/London"
+	Sydney  = "Australia/Sydney"
+)
+
+// Transition is a daylight saving time change in a named location.
+type Transition struct {
+	// Name identifies the transition, such as "America/New_York spring
+	// forward".
+	Name string
+
+	// Location is the location the transition happens in.
+	Location *time.Location
+
+	// At is the first instant with the new offset, in Location. At minus one
+	// nanosecond still has the old offset.
+	At time.Time
+
+	// Forward is true when clocks move forward and an hour of wall-clock
+	// time is skipped, and false when they move back and an hour repeats.
+	Forward bool
+}
+
+// Transitions returns the 2024 DST transitions in NewYork, London, and
+// Sydney. Sydney is in the southern hemisphere, so its clocks fall back in
+// April and spring forward in October.
+//
+//	America/New_York spring forward  2024-03-10T07:00:00Z  02:00 EST -> 03:00 EDT
+//	America/New_York fall back       2024-11-03T06:00:00Z  02:00 EDT -> 01:00 EST
+//	Europe/London spring forward     2024-03-31T01:00:00Z  01:00 GMT -> 02:00 BST
+//	Europe/London fall back          2024-10-27T01:00:00Z  02:00 BST -> 01:00 GMT
+//	Australia/Sydney fall back       2024-04-06T16:00:00Z  03:00 AEDT -> 02:00 AEST
+//	Australia/Sydney spring forward  2024-10-05T16:00:00Z  02:00 AEST -> 03:00 AEDT
+func Transitions() []Transition {
+	return []Transition{
+		transition(NewYork, time.Date(2024, time.March, 10, 7, 0, 0, 0, time.UTC), true),
+		transition(NewYork, time.Date(2024, time.November, 3, 6, 0, 0, 0, time.UTC), false),
+		transition(London, time.Date(2024, time.March, 31, 1, 0, 0, 0, time.UTC), true),
+		transition(London, time.Date(2024, time.October, 27, 1, 0, 0, 0, time.UTC), false),
+		transition(Sydney, time.Date(2024, time.April, 6, 16, 0, 0, 0, time.UTC), false),
+		transition(Sydney, time.Date(2024, time.October, 5, 16, 0, 0, 0, time.UTC), true),
+	}
+}
+
+// SpringForward returns 2024-03-10 03:00 EDT in America/New_York, the first
+// instant after clocks skip from 02:00 to 03:00. Wall-clock times between
+// 02:00 and 03:00 that day do not exist.
+func SpringForward() time.Time {
+	return Transitions()[0].At
+}
+
+// FallBack returns 2024-11-03 01:00 EST in America/New_York, the first
+// instant after clocks go back from 02:00 to 01:00. Wall-clock times between
+// 01:00 and 02:00 that day happen twice, so FallBack minus one hour reads the
+// same 01:00 in EDT.
+func FallBack() time.Time {
+	return Transitions()[1].At
+}
+
+// transition builds a Transition in the named location at the instant at.
+func transition(name string, at time.Time, forward bool) Transition {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(fmt.Sprintf("testtime: failed to load location %q: %v", name, err))
+	}
+
+	kind := "fall back"
+	if forward {
+		kind = "spring forward"
+	}
+	return Transition{
+		Name:     name + " " + kind,
+		Location: loc,
+		At:       at.In(loc),
+		Forward:  forward,
+	}
+}
@@ -0,0 +1,65 @@
+package testtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransitions(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[string]bool)
+	for _, tc := range Transitions() {
+		if seen[tc.Name] {
+			t.Fatalf("Duplicate transition name %q", tc.Name)
+		}
+		seen[tc.Name] = true
+
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if tc.At.Location() != tc.Location {
+				t.Errorf("Expected At in %s, got %s", tc.Location, tc.At.Location())
+			}
+
+			_, before := tc.At.Add(-time.Nanosecond).Zone()
+			_, after := tc.At.Zone()
+			if before == after {
+				t.Fatalf("Expected the offset to change at %s", tc.At)
+			}
+			if forward := after > before; forward != tc.Forward {
+				t.Errorf(
+					"Expected Forward to be %t, offsets went from %d to %d",
+					forward,
+					before,
+					after,
+				)
+			}
+			if start, _ := tc.At.ZoneBounds(); !start.Equal(tc.At) {
+				t.Errorf("Expected the zone to start at %s, got %s", tc.At, start)
+			}
+		})
+	}
+}
+
+func TestSpringForwardAndFallBack(t *testing.T) {
+	t.Parallel()
+	loc := SpringForward().Location()
+
+	// 02:30 does not exist on the spring-forward day and is normalized.
+	gap := time.Date(2024, time.March, 10, 2, 30, 0, 0, loc)
+	if gap.Hour() == 2 {
+		t.Errorf("Expected 02:30 to be skipped, got %s", gap)
+	}
+	if SpringForward().Hour() != 3 {
+		t.Errorf("Expected 03:00, got %s", SpringForward())
+	}
+
+	// 01:00 happens twice on the fall-back day.
+	first := FallBack().Add(-time.Hour)
+	if first.Hour() != FallBack().Hour() {
+		t.Errorf("Expected the hour to repeat, got %s and %s", first, FallBack())
+	}
+	if first.Equal(FallBack()) {
+		t.Error("Expected the repeated hours to be different instants")
+	}
+}
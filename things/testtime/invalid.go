@@ -0,0 +1,298 @@
+package testtime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// InvalidString is a time string that time.Parse rejects for Layout, along
+// with the error it is expected to return.
+type InvalidString struct {
+	// Name briefly describes what is wrong with Input.
+	Name string
+
+	// Layout is the layout to parse Input with, such as time.RFC3339.
+	Layout string
+
+	// Input is the raw string to hand to time.Parse.
+	Input string
+
+	// ParseErr is a substring of the error time.Parse returns for Input.
+	ParseErr string
+}
+
+// InvalidStrings returns a fresh copy of the invalid time string corpus,
+// grouped by layout:
+//
+//	RFC3339      missing offset                "2024-01-01T00:00:00"
+//	RFC3339      space instead of T            "2024-01-01 00:00:00Z"
+//	RFC3339      date only                     "2024-01-01"
+//	RFC3339      lowercase z                   "2024-01-01T00:00:00z"
+//	RFC3339      offset without colon          "2024-01-01T00:00:00+0100"
+//	RFC3339      offset out of range           "2024-01-01T00:00:00+25:00"
+//	RFC3339      single-digit month            "2024-1-01T00:00:00Z"
+//	RFC3339      two-digit year                "24-01-01T00:00:00Z"
+//	RFC3339      five-digit year               "10000-01-01T00:00:00Z"
+//	RFC3339      negative year                 "-2024-01-01T00:00:00Z"
+//	RFC3339      month 13                      "2024-13-01T00:00:00Z"
+//	RFC3339      February 30                   "2024-02-30T00:00:00Z"
+//	RFC3339      February 29 in a common year  "2023-02-29T00:00:00Z"
+//	RFC3339      hour 24                       "2024-01-01T24:00:00Z"
+//	RFC3339      minute 60                     "2024-01-01T00:60:00Z"
+//	RFC3339      leap second                   "2016-12-31T23:59:60Z"
+//	RFC3339      trailing text                 "2024-01-01T00:00:00Z extra"
+//	RFC3339      empty string                  ""
+//	RFC3339Nano  dot without digits            "2024-01-01T00:00:00.Z"
+//	RFC1123      missing zone                  "Mon, 02 Jan 2006 15:04:05"
+//	RFC1123      full weekday                  "Monday, 02 Jan 2006 15:04:05 MST"
+//	RFC1123      unknown weekday               "Xyz, 02 Jan 2006 15:04:05 MST"
+//	RFC1123      unpadded day                  "Mon, 2 Jan 2006 15:04:05 MST"
+//	RFC1123      full month                    "Mon, 02 January 2006 15:04:05 MST"
+//	RFC1123      two-digit year                "Mon, 02 Jan 06 15:04:05 MST"
+//	RFC1123Z     zone name instead of offset   "Mon, 02 Jan 2006 15:04:05 MST"
+//	RFC822       missing zone                  "02 Jan 06 15:04"
+//	ANSIC        missing year                  "Mon Jan  2 15:04:05"
+//	Kitchen      hour 13                       "13:00PM"
+//	Kitchen      missing AM/PM                 "3:04"
+//	DateTime     T separator                   "2024-01-01T00:00:00"
+//	DateOnly     slash separators              "2024/01/01"
+//	DateOnly     February 30                   "2024-02-30"
+//
+// Out-of-range values fail even though they match the layout, so they reach
+// the validation code a hand-written parser might skip.
+func InvalidStrings() []InvalidString {
+	return []InvalidString{
+		{
+			Name:     "missing offset",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T00:00:00",
+			ParseErr: `cannot parse "" as "Z07:00"`,
+		},
+		{
+			Name:     "space instead of T",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01 00:00:00Z",
+			ParseErr: `cannot parse " 00:00:00Z" as "T"`,
+		},
+		{
+			Name:     "date only",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01",
+			ParseErr: `cannot parse "" as "T"`,
+		},
+		{
+			Name:     "lowercase z",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T00:00:00z",
+			ParseErr: `cannot parse "z" as "Z07:00"`,
+		},
+		{
+			Name:     "offset without colon",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T00:00:00+0100",
+			ParseErr: `cannot parse "+0100" as "Z07:00"`,
+		},
+		{
+			Name:     "offset out of range",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T00:00:00+25:00",
+			ParseErr: "time zone offset hour out of range",
+		},
+		{
+			Name:     "single-digit month",
+			Layout:   time.RFC3339,
+			Input:    "2024-1-01T00:00:00Z",
+			ParseErr: `cannot parse "1-01T00:00:00Z" as "01"`,
+		},
+		{
+			Name:     "two-digit year",
+			Layout:   time.RFC3339,
+			Input:    "24-01-01T00:00:00Z",
+			ParseErr: `cannot parse "24-01-01T00:00:00Z" as "2006"`,
+		},
+		{
+			Name:     "five-digit year",
+			Layout:   time.RFC3339,
+			Input:    "10000-01-01T00:00:00Z",
+			ParseErr: `cannot parse "0-01-01T00:00:00Z" as "-"`,
+		},
+		{
+			Name:     "negative year",
+			Layout:   time.RFC3339,
+			Input:    "-2024-01-01T00:00:00Z",
+			ParseErr: `cannot parse "-2024-01-01T00:00:00Z" as "2006"`,
+		},
+		{
+			Name:     "month 13",
+			Layout:   time.RFC3339,
+			Input:    "2024-13-01T00:00:00Z",
+			ParseErr: "month out of range",
+		},
+		{
+			Name:     "February 30",
+			Layout:   time.RFC3339,
+			Input:    "2024-02-30T00:00:00Z",
+			ParseErr: "day out of range",
+		},
+		{
+			Name:     "February 29 in a common year",
+			Layout:   time.RFC3339,
+			Input:    "2023-02-29T00:00:00Z",
+			ParseErr: "day out of range",
+		},
+		{
+			Name:     "hour 24",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T24:00:00Z",
+			ParseErr: "hour out of range",
+		},
+		{
+			Name:     "minute 60",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T00:60:00Z",
+			ParseErr: "minute out of range",
+		},
+		{
+			Name:     "leap second",
+			Layout:   time.RFC3339,
+			Input:    LeapSecondString,
+			ParseErr: "second out of range",
+		},
+		{
+			Name:     "trailing text",
+			Layout:   time.RFC3339,
+			Input:    "2024-01-01T00:00:00Z extra",
+			ParseErr: `extra text: " extra"`,
+		},
+		{
+			Name:     "empty string",
+			Layout:   time.RFC3339,
+			Input:    "",
+			ParseErr: `cannot parse "" as "2006"`,
+		},
+		{
+			Name:     "dot without digits",
+			Layout:   time.RFC3339Nano,
+			Input:    "2024-01-01T00:00:00.Z",
+			ParseErr: `cannot parse ".Z" as "Z07:00"`,
+		},
+		{
+			Name:     "missing zone",
+			Layout:   time.RFC1123,
+			Input:    "Mon, 02 Jan 2006 15:04:05",
+			ParseErr: `cannot parse "" as "MST"`,
+		},
+		{
+			Name:     "full weekday",
+			Layout:   time.RFC1123,
+			Input:    "Monday, 02 Jan 2006 15:04:05 MST",
+			ParseErr: `cannot parse "day, 02 Jan 2006 15:04:05 MST" as ", "`,
+		},
+		{
+			Name:     "unknown weekday",
+			Layout:   time.RFC1123,
+			Input:    "Xyz, 02 Jan 2006 15:04:05 MST",
+			ParseErr: `cannot parse "Xyz, 02 Jan 2006 15:04:05 MST" as "Mon"`,
+		},
+		{
+			Name:     "unpadded day",
+			Layout:   time.RFC1123,
+			Input:    "Mon, 2 Jan 2006 15:04:05 MST",
+			ParseErr: `cannot parse "2 Jan 2006 15:04:05 MST" as "02"`,
+		},
+		{
+			Name:     "full month",
+			Layout:   time.RFC1123,
+			Input:    "Mon, 02 January 2006 15:04:05 MST",
+			ParseErr: `cannot parse "uary 2006 15:04:05 MST" as " "`,
+		},
+		{
+			Name:     "two-digit year",
+			Layout:   time.RFC1123,
+			Input:    "Mon, 02 Jan 06 15:04:05 MST",
+			ParseErr: `cannot parse "06 15:04:05 MST" as "2006"`,
+		},
+		{
+			Name:     "zone name instead of offset",
+			Layout:   time.RFC1123Z,
+			Input:    "Mon, 02 Jan 2006 15:04:05 MST",
+			ParseErr: `cannot parse "MST" as "-0700"`,
+		},
+		{
+			Name:     "missing zone",
+			Layout:   time.RFC822,
+			Input:    "02 Jan 06 15:04",
+			ParseErr: `cannot parse "" as "MST"`,
+		},
+		{
+			Name:     "missing year",
+			Layout:   time.ANSIC,
+			Input:    "Mon Jan  2 15:04:05",
+			ParseErr: `cannot parse "" as "2006"`,
+		},
+		{
+			Name:     "hour 13",
+			Layout:   time.Kitchen,
+			Input:    "13:00PM",
+			ParseErr: "hour out of range",
+		},
+		{
+			Name:     "missing AM/PM",
+			Layout:   time.Kitchen,
+			Input:    "3:04",
+			ParseErr: `cannot parse "" as "PM"`,
+		},
+		{
+			Name:     "T separator",
+			Layout:   time.DateTime,
+			Input:    "2024-01-01T00:00:00",
+			ParseErr: `cannot parse "T00:00:00" as " "`,
+		},
+		{
+			Name:     "slash separators",
+			Layout:   time.DateOnly,
+			Input:    "2024/01/01",
+			ParseErr: `cannot parse "/01/01" as "-"`,
+		},
+		{
+			Name:     "February 30",
+			Layout:   time.DateOnly,
+			Input:    "2024-02-30",
+			ParseErr: "day out of range",
+		},
+	}
+}
+
+// InvalidStringsFor returns the entries of InvalidStrings whose Layout is
+// layout.
+func InvalidStringsFor(layout string) []InvalidString {
+	var out []InvalidString
+	for _, s := range InvalidStrings() {
+		if s.Layout == layout {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// MustFailParse is a helper function that checks an InvalidString against
+// time.Parse and panics unless it fails with an error containing ParseErr.
+// It returns the error.
+func MustFailParse(s InvalidString) error {
+	_, err := time.Parse(s.Layout, s.Input)
+	switch {
+	case err == nil:
+		panic(fmt.Sprintf("expected time.Parse to reject %q for layout %q", s.Input, s.Layout))
+	case !strings.Contains(err.Error(), s.ParseErr):
+		panic(
+			fmt.Sprintf(
+				"expected time.Parse error for %q to contain %q, got %v",
+				s.Input,
+				s.ParseErr,
+				err,
+			),
+		)
+	}
+	return err
+}
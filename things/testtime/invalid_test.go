@@ -0,0 +1,117 @@
+package testtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvalidStrings(t *testing.T) {
+	t.Parallel()
+
+	entries := InvalidStrings()
+	if len(entries) == 0 {
+		t.Fatal("Expected a non-empty corpus")
+	}
+
+	seen := make(map[string]bool)
+	for _, tc := range entries {
+		key := tc.Layout + " " + tc.Name
+		if seen[key] {
+			t.Fatalf("Duplicate entry name %q", key)
+		}
+		seen[key] = true
+
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+			if tc.ParseErr == "" {
+				t.Fatal("Expected a ParseErr")
+			}
+			if err := MustFailParse(tc); err == nil {
+				t.Error("Expected MustFailParse to return the parse error")
+			}
+		})
+	}
+}
+
+func TestInvalidStringsReturnsCopy(t *testing.T) {
+	t.Parallel()
+
+	first := InvalidStrings()
+	first[0].Input = "changed"
+	if InvalidStrings()[0].Input == "changed" {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
+
+func TestInvalidStringsFor(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		layout string
+		empty  bool
+	}{
+		{time.RFC3339, false},
+		{time.RFC1123, false},
+		{time.Kitchen, false},
+		{time.StampMilli, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.layout, func(t *testing.T) {
+			t.Parallel()
+			got := InvalidStringsFor(tc.layout)
+			if (len(got) == 0) != tc.empty {
+				t.Fatalf("Expected empty to be %t, got %d entries", tc.empty, len(got))
+			}
+			for _, s := range got {
+				if s.Layout != tc.layout {
+					t.Errorf("Expected layout %q, got %q", tc.layout, s.Layout)
+				}
+			}
+		})
+	}
+}
+
+func TestMustFailParse(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name  string
+		entry InvalidString
+		panic bool
+	}{
+		{
+			name: "Matching entry",
+			entry: InvalidString{
+				Layout:   time.DateOnly,
+				Input:    "2024-02-30",
+				ParseErr: "day out of range",
+			},
+			panic: false,
+		},
+		{
+			name:  "Parse unexpectedly succeeds",
+			entry: InvalidString{Layout: time.DateOnly, Input: "2024-02-29", ParseErr: "anything"},
+			panic: true,
+		},
+		{
+			name: "Error mismatch",
+			entry: InvalidString{
+				Layout:   time.DateOnly,
+				Input:    "2024-02-30",
+				ParseErr: "month out of range",
+			},
+			panic: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); (r != nil) != tc.panic {
+					t.Errorf("Expected panic to be %t, got %v", tc.panic, r)
+				}
+			}()
+			MustFailParse(tc.entry)
+		})
+	}
+}
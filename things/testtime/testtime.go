@@ -0,0 +1,126 @@
+/*
+Package testtime provides time.Time values that sit on the edges where time
+handling tends to break: the epoch, the zero Time, Y2038, leap days, DST
+transitions, leap seconds, the limits of UnixNano, and the monotonic clock.
+
+	github.com/madflojo/testlazy/things/testtime
+
+Every function returns a fresh value, in UTC unless it is tied to a named
+location. The time zone database is embedded through time/tzdata, so the
+DST fixtures behave the same on machines without one.
+
+Example usage
+
+	got := expiry(testtime.Y2038())
+	if got.Before(testtime.Y2038()) {
+	    t.Errorf("expiry went backwards past Y2038: %s", got)
+	}
+
+	for _, tr := range testtime.Transitions() {
+	    t.Run(tr.Name, func(t *testing.T) {
+	        schedule(t, tr.At.Add(-time.Minute), time.Hour)
+	    })
+	}
+
+InvalidStrings lists malformed time strings for time.Parse, grouped by
+layout.
+*/
+package testtime
+
+import (
+	"time"
+)
+
+// LeapSecondString is the leap second inserted at the end of 2016 in RFC 3339
+// form. Go has no representation for second 60, so time.Parse rejects it.
+const LeapSecondString = "2016-12-31T23:59:60Z"
+
+// Epoch returns the Unix epoch, 1970-01-01T00:00:00Z.
+func Epoch() time.Time {
+	return time.Unix(0, 0).UTC()
+}
+
+// Zero returns the zero time.Time, 0001-01-01T00:00:00Z, for which IsZero
+// reports true. Its Unix value is far below the epoch.
+func Zero() time.Time {
+	return time.Time{}
+}
+
+// Y2038 returns 2038-01-19T03:14:07Z, the last second a signed 32-bit Unix
+// timestamp can hold.
+func Y2038() time.Time {
+	return time.Unix(1<<31-1, 0).UTC()
+}
+
+// AfterY2038 returns 2038-01-19T03:14:08Z, 2^31 seconds after the epoch and
+// the first second that overflows a signed 32-bit Unix timestamp.
+func AfterY2038() time.Time {
+	return time.Unix(1<<31, 0).UTC()
+}
+
+// LeapDay returns noon on 2024-02-29.
+func LeapDay() time.Time {
+	return time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC)
+}
+
+// LeapDayCentury returns noon on 2000-02-29. 2000 is a leap year because it
+// is divisible by 400, although centuries usually are not.
+func LeapDayCentury() time.Time {
+	return time.Date(2000, time.February, 29, 12, 0, 0, 0, time.UTC)
+}
+
+// EndOfLeapYear returns the last nanosecond of 2024, on day 366 of the year.
+func EndOfLeapYear() time.Time {
+	return time.Date(2024, time.December, 31, 23, 59, 59, 999999999, time.UTC)
+}
+
+// LeapSecond returns 2016-12-31T23:59:59Z, the second before the leap second
+// in LeapSecondString. Go, like Unix time, skips the leap second itself.
+func LeapSecond() time.Time {
+	return time.Date(2016, time.December, 31, 23, 59, 59, 0, time.UTC)
+}
+
+// AfterLeapSecond returns 2017-01-01T00:00:00Z, the second after the leap
+// second in LeapSecondString. Go reports it one second after LeapSecond,
+// although two SI seconds passed.
+func AfterLeapSecond() time.Time {
+	return time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// FarFuture returns the last nanosecond of year 9999, the latest time that
+// RFC 3339 and MarshalJSON can represent.
+func FarFuture() time.Time {
+	return time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC)
+}
+
+// FarPast returns one nanosecond after the zero Time, the earliest time in
+// year 1 for which IsZero reports false.
+func FarPast() time.Time {
+	return time.Time{}.Add(time.Nanosecond).UTC()
+}
+
+// MaxUnixNano returns 2262-04-11T23:47:16.854775807Z, the latest time
+// UnixNano can represent. Later times overflow int64.
+func MaxUnixNano() time.Time {
+	return time.Unix(0, 1<<63-1).UTC()
+}
+
+// MinUnixNano returns 1677-09-21T00:12:43.145224192Z, the earliest time
+// UnixNano can represent.
+func MinUnixNano() time.Time {
+	return time.Unix(0, -1<<63).UTC()
+}
+
+// Monotonic returns the current time with its monotonic clock reading, as
+// time.Now does. Sub and Before on two such values ignore wall-clock jumps.
+func Monotonic() time.Time {
+	return time.Now()
+}
+
+// WallClock returns the current time with the monotonic clock reading
+// stripped, as a time decoded from JSON or a database would be. Compared
+// with == to a Monotonic value for the same instant it is not equal, but
+// Equal reports true.
+func WallClock() time.Time {
+	return time.Now().Round(0)
+}
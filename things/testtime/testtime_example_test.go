@@ -0,0 +1,48 @@
+package testtime
+
+import (
+	"fmt"
+	"time"
+)
+
+func ExampleY2038() {
+	fmt.Println(Y2038(), int32(Y2038().Unix()))
+	// Output: 2038-01-19 03:14:07 +0000 UTC 2147483647
+}
+
+func ExampleEndOfLeapYear() {
+	fmt.Println(EndOfLeapYear().YearDay())
+	// Output: 366
+}
+
+func ExampleAfterLeapSecond() {
+	fmt.Println(AfterLeapSecond().Sub(LeapSecond()))
+	// Output: 1s
+}
+
+func ExampleTransitions() {
+	for _, tr := range Transitions()[:2] {
+		fmt.Println(tr.Name, tr.At.Format(time.RFC3339))
+	}
+	// Output:
+	// America/New_York spring forward 2024-03-10T03:00:00-04:00
+	// America/New_York fall back 2024-11-03T01:00:00-05:00
+}
+
+func ExampleFallBack() {
+	fmt.Println(FallBack().Add(-time.Hour))
+	fmt.Println(FallBack())
+	// Output:
+	// 2024-11-03 01:00:00 -0400 EDT
+	// 2024-11-03 01:00:00 -0500 EST
+}
+
+func ExampleInvalidStringsFor() {
+	for _, s := range InvalidStringsFor(time.Kitchen) {
+		_, err := time.Parse(s.Layout, s.Input)
+		fmt.Println(err)
+	}
+	// Output:
+	// parsing time "13:00PM": hour out of range
+	// parsing time "3:04" as "3:04PM": cannot parse "" as "PM"
+}
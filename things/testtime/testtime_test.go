@@ -0,0 +1,98 @@
+package testtime
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInstants(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		fn   func() time.Time
+		want string
+	}{
+		{"Epoch", Epoch, "1970-01-01T00:00:00Z"},
+		{"Zero", Zero, "0001-01-01T00:00:00Z"},
+		{"Y2038", Y2038, "2038-01-19T03:14:07Z"},
+		{"AfterY2038", AfterY2038, "2038-01-19T03:14:08Z"},
+		{"LeapDay", LeapDay, "2024-02-29T12:00:00Z"},
+		{"LeapDayCentury", LeapDayCentury, "2000-02-29T12:00:00Z"},
+		{"EndOfLeapYear", EndOfLeapYear, "2024-12-31T23:59:59.999999999Z"},
+		{"LeapSecond", LeapSecond, "2016-12-31T23:59:59Z"},
+		{"AfterLeapSecond", AfterLeapSecond, "2017-01-01T00:00:00Z"},
+		{"FarFuture", FarFuture, "9999-12-31T23:59:59.999999999Z"},
+		{"FarPast", FarPast, "0001-01-01T00:00:00.000000001Z"},
+		{"MaxUnixNano", MaxUnixNano, "2262-04-11T23:47:16.854775807Z"},
+		{"MinUnixNano", MinUnixNano, "1677-09-21T00:12:43.145224192Z"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := tc.fn()
+			if s := got.Format(time.RFC3339Nano); s != tc.want {
+				t.Errorf("Expected %s to be %q, got %q", tc.name, tc.want, s)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("Expected %s to be in UTC, got %s", tc.name, got.Location())
+			}
+		})
+	}
+}
+
+func TestEdges(t *testing.T) {
+	t.Parallel()
+
+	if !Zero().IsZero() || FarPast().IsZero() {
+		t.Error("Expected only Zero to report IsZero")
+	}
+	if Epoch().Unix() != 0 {
+		t.Errorf("Expected Epoch Unix to be 0, got %d", Epoch().Unix())
+	}
+	if int64(int32(Y2038().Unix())) != Y2038().Unix() {
+		t.Error("Expected Y2038 to fit in int32")
+	}
+	if int64(int32(AfterY2038().Unix())) == AfterY2038().Unix() {
+		t.Error("Expected AfterY2038 to overflow int32")
+	}
+	if EndOfLeapYear().YearDay() != 366 {
+		t.Errorf("Expected day 366, got %d", EndOfLeapYear().YearDay())
+	}
+	if d := AfterLeapSecond().Sub(LeapSecond()); d != time.Second {
+		t.Errorf("Expected one second across the leap second, got %s", d)
+	}
+	if MaxUnixNano().Add(time.Nanosecond).UnixNano() > 0 {
+		t.Error("Expected UnixNano to overflow after MaxUnixNano")
+	}
+	if MinUnixNano().UnixNano() != -1<<63 {
+		t.Errorf("Expected MinUnixNano to be the smallest int64, got %d", MinUnixNano().UnixNano())
+	}
+	if _, err := json.Marshal(FarFuture()); err != nil {
+		t.Errorf("Expected FarFuture to marshal, got %v", err)
+	}
+	if _, err := json.Marshal(FarFuture().Add(time.Nanosecond)); err == nil {
+		t.Error("Expected the year after FarFuture not to marshal")
+	}
+	if _, err := time.Parse(time.RFC3339, LeapSecondString); err == nil {
+		t.Error("Expected LeapSecondString to be rejected")
+	}
+}
+
+func TestMonotonic(t *testing.T) {
+	t.Parallel()
+	if !strings.Contains(Monotonic().String(), "m=") {
+		t.Errorf("Expected a monotonic reading, got %s", Monotonic())
+	}
+	if strings.Contains(WallClock().String(), "m=") {
+		t.Errorf("Expected no monotonic reading, got %s", WallClock())
+	}
+
+	m := Monotonic()
+	w := m.Round(0)
+	if m == w || !m.Equal(w) {
+		t.Error("Expected the readings to differ under == but not under Equal")
+	}
+}
@@ -0,0 +1,126 @@
+package testurl
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// Builder assembles a *url.URL one component at a time for the combinations
+// the predefined helpers do not cover. Start with New, chain the setters you
+// need, and finish with URL or String.
+//
+// Every setter returns the Builder so calls can be chained. URL returns a new
+// *url.URL on each call, so a Builder can be reused as a template.
+type Builder struct {
+	scheme   string
+	host     string
+	port     string
+	path     string
+	rawPath  string
+	query    OrderedValues
+	fragment string
+	user     *url.Userinfo
+}
+
+// New returns a Builder that yields "https://example.com/" until changed.
+func New() *Builder {
+	return &Builder{
+		scheme: "https",
+		host:   ExampleHost,
+		path:   "/",
+	}
+}
+
+// Scheme sets the URL scheme, such as "http" or "wss".
+func (b *Builder) Scheme(scheme string) *Builder {
+	b.scheme = scheme
+	return b
+}
+
+// Host sets the host name or IP address without a port. IPv6 addresses are
+// bracketed automatically.
+func (b *Builder) Host(host string) *Builder {
+	b.host = host
+	return b
+}
+
+// Port sets the port appended to the host. An empty port removes it.
+func (b *Builder) Port(port string) *Builder {
+	b.port = port
+	return b
+}
+
+// Path sets the unescaped path and clears any RawPath set earlier.
+func (b *Builder) Path(path string) *Builder {
+	b.path = path
+	b.rawPath = ""
+	return b
+}
+
+// RawPath sets the path from its escaped form, preserving encodings such as
+// %2F that Path would normalize away. It panics if rawPath is not a valid
+// escaped path.
+func (b *Builder) RawPath(rawPath string) *Builder {
+	path, err := url.PathUnescape(rawPath)
+	if err != nil {
+		panic(fmt.Sprintf("invalid raw path %q: %v", rawPath, err))
+	}
+	b.path = path
+	b.rawPath = rawPath
+	return b
+}
+
+// Query appends a key/value pair to the query string. Calling it more than
+// once with the same key produces repeated keys, and pairs are encoded in the
+// order they were added.
+func (b *Builder) Query(key, value string) *Builder {
+	b.query.Add(key, value)
+	return b
+}
+
+// Fragment sets the fragment, without the leading "#".
+func (b *Builder) Fragment(fragment string) *Builder {
+	b.fragment = fragment
+	return b
+}
+
+// User sets a username with no password.
+func (b *Builder) User(username string) *Builder {
+	b.user = url.User(username)
+	return b
+}
+
+// UserPassword sets a username and password.
+func (b *Builder) UserPassword(username, password string) *Builder {
+	b.user = url.UserPassword(username, password)
+	return b
+}
+
+// URL returns a new *url.URL built from the current settings.
+func (b *Builder) URL() *url.URL {
+	host := strings.TrimSuffix(strings.TrimPrefix(b.host, "["), "]")
+	if b.port != "" {
+		host = net.JoinHostPort(host, b.port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	u := &url.URL{
+		Scheme:   b.scheme,
+		Host:     host,
+		Path:     b.path,
+		RawPath:  b.rawPath,
+		RawQuery: b.query.Encode(),
+		Fragment: b.fragment,
+		User:     b.user, // Userinfo is immutable, so sharing it is safe.
+	}
+
+	return u
+}
+
+// String returns the URL built from the current settings as a string.
+func (b *Builder) String() string {
+	return b.URL().String()
+}
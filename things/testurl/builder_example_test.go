@@ -0,0 +1,26 @@
+package testurl
+
+import (
+	"fmt"
+)
+
+func ExampleNew() {
+	fmt.Println(New().String())
+	// Output: https://example.com/
+}
+
+// ExampleBuilder demonstrates chaining setters to build a custom URL.
+func ExampleBuilder() {
+	u := New().
+		Scheme("http").
+		Host(LocalhostHost).
+		Port(HTTPPort).
+		Path("/search").
+		Query("tag", "go").
+		Query("tag", "test").
+		Fragment("results").
+		URL()
+
+	fmt.Println(u.String())
+	// Output: http://localhost:8080/search?tag=go&tag=test#results
+}
@@ -0,0 +1,104 @@
+package testurl
+
+import (
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		builder  *Builder
+		expected string
+	}{
+		{"Defaults", New(), "https://" + testExampleHost + "/"},
+		{"Scheme", New().Scheme("wss"), "wss://" + testExampleHost + "/"},
+		{"Host", New().Host(testLocalhostHost), "https://" + testLocalhostHost + "/"},
+		{"Port", New().Port("9000"), "https://" + testExampleHost + ":9000/"},
+		{"IPv6Host", New().Host("::1"), "https://[::1]/"},
+		{"IPv6HostWithPort", New().Host("[::1]").Port("8443"), "https://[::1]:8443/"},
+		{"Path", New().Path("/a b/c"), "https://" + testExampleHost + "/a%20b/c"},
+		{
+			"RawPath",
+			New().RawPath("/files/a%2Fb"),
+			"https://" + testExampleHost + "/files/a%2Fb",
+		},
+		{
+			"RepeatedQueryKeepsOrder",
+			New().Query("b", "2").Query("a", "1").Query("b", "3"),
+			"https://" + testExampleHost + "/?b=2&a=1&b=3",
+		},
+		{
+			"QueryEscaped",
+			New().Query("q", "a&b c"),
+			"https://" + testExampleHost + "/?q=a%26b+c",
+		},
+		{"Fragment", New().Fragment("top"), "https://" + testExampleHost + "/#top"},
+		{"User", New().User("alice"), "https://alice@" + testExampleHost + "/"},
+		{
+			"UserPassword",
+			New().UserPassword("alice", "s3cret"),
+			"https://alice:s3cret@" + testExampleHost + "/",
+		},
+		{
+			"Everything",
+			New().Scheme("http").
+				Host(testLocalhostHost).
+				Port("8080").
+				Path("/path/to/resource").
+				Query("query", "1").
+				Fragment("fragment").
+				User("bob"),
+			"http://bob@" + testLocalhostHost + ":8080/path/to/resource?query=1#fragment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.builder.String(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			if got := tc.builder.URL().String(); got != tc.expected {
+				t.Errorf("Expected URL() to be %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestBuilderReturnsFreshURL(t *testing.T) {
+	t.Parallel()
+
+	b := New().Query("a", "1")
+	first := b.URL()
+	first.Path = "/changed"
+	first.RawQuery = ""
+
+	second := b.URL()
+	if first == second {
+		t.Fatal("Expected a new *url.URL on each call")
+	}
+	if second.String() != "https://"+testExampleHost+"/?a=1" {
+		t.Errorf("Expected builder to be unaffected by edits, got %q", second.String())
+	}
+}
+
+func TestBuilderPathClearsRawPath(t *testing.T) {
+	t.Parallel()
+
+	u := New().RawPath("/a%2Fb").Path("/c").URL()
+	if u.RawPath != "" || u.Path != "/c" {
+		t.Errorf("Expected Path to replace RawPath, got Path=%q RawPath=%q", u.Path, u.RawPath)
+	}
+}
+
+func TestBuilderRawPathPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for invalid raw path")
+		}
+	}()
+	New().RawPath("/%zz")
+}
@@ -0,0 +1,278 @@
+package testurl
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// NormalizeOptions loosens the comparison done by Normalize and RequireEqual
+// beyond the RFC 3986 normalizations that are always applied.
+type NormalizeOptions struct {
+	// IgnoreQueryOrder sorts query parameters by key. Repeated keys keep
+	// their relative order, since that is usually meaningful.
+	IgnoreQueryOrder bool
+
+	// IgnoreDefaultPort drops the port when it is the default for the
+	// scheme, so "https://example.com:443/" equals "https://example.com/".
+	IgnoreDefaultPort bool
+
+	// IgnoreFragment drops the fragment.
+	IgnoreFragment bool
+
+	// IgnoreTrailingSlash drops a trailing "/" from any path longer than "/",
+	// so "/path/" equals "/path".
+	IgnoreTrailingSlash bool
+}
+
+// defaultPorts maps schemes to the port used when none is given.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// Normalize returns a new *url.URL equivalent to u in RFC 3986 normal form:
+// the scheme and host are lowercased (keeping any IPv6 zone as written, since
+// zone IDs can be case-sensitive), percent-encodings use uppercase hex,
+// percent-encoded unreserved characters are decoded, dot segments are
+// removed, and an empty path with a host becomes "/". The options in opts
+// apply further, lossy normalizations. u is not modified.
+func Normalize(u *url.URL, opts NormalizeOptions) *url.URL {
+	if u == nil {
+		return nil
+	}
+
+	n := *u
+	n.Scheme = strings.ToLower(n.Scheme)
+	n.Host = lowerHost(n.Host)
+
+	if opts.IgnoreDefaultPort && n.Port() != "" && defaultPorts[n.Scheme] == n.Port() {
+		n.Host = strings.TrimSuffix(n.Host, ":"+n.Port())
+	}
+
+	if n.Opaque == "" {
+		p := removeDotSegments(normalizeEscapes(u.EscapedPath()))
+		if opts.IgnoreTrailingSlash && len(p) > 1 {
+			p = strings.TrimSuffix(p, "/")
+		}
+		if p == "" && n.Host != "" {
+			p = "/"
+		}
+		n.Path, _ = url.PathUnescape(p)
+		n.RawPath = p
+		if n.EscapedPath() != p {
+			n.RawPath = ""
+		}
+	}
+
+	n.RawQuery = normalizeEscapes(n.RawQuery)
+	if opts.IgnoreQueryOrder && n.RawQuery != "" {
+		pairs := strings.Split(n.RawQuery, "&")
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return queryKey(pairs[i]) < queryKey(pairs[j])
+		})
+		n.RawQuery = strings.Join(pairs, "&")
+	}
+
+	if opts.IgnoreFragment {
+		n.Fragment = ""
+		n.RawFragment = ""
+	} else if n.Fragment != "" {
+		f := normalizeEscapes(u.EscapedFragment())
+		n.Fragment, _ = url.PathUnescape(f)
+		n.RawFragment = f
+	}
+
+	return &n
+}
+
+// RequireEqual fails the test unless want and got are equal after both are
+// passed through Normalize with opts. On failure it reports each component
+// that differs instead of two long strings.
+func RequireEqual(t testing.TB, want, got *url.URL, opts NormalizeOptions) {
+	t.Helper()
+	if msg := equalFailure(want, got, opts); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// equalFailure returns the message RequireEqual fails with, or "" if want
+// and got are equal.
+func equalFailure(want, got *url.URL, opts NormalizeOptions) string {
+	if want == nil || got == nil {
+		if want != got {
+			return fmt.Sprintf("testurl: URLs differ\n  want: %v\n  got:  %v", want, got)
+		}
+		return ""
+	}
+
+	w, g := Normalize(want, opts), Normalize(got, opts)
+	if w.String() == g.String() {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "testurl: URLs differ after normalization\n  want: %s\n  got:  %s", w, g)
+	for _, line := range diffURL(w, g) {
+		b.WriteString("\n  " + line)
+	}
+	return b.String()
+}
+
+// diffURL lists the components of two normalized URLs that differ.
+func diffURL(w, g *url.URL) []string {
+	var lines []string
+	field := func(name, want, got string) {
+		if want != got {
+			lines = append(lines, fmt.Sprintf("%s: want %q, got %q", name, want, got))
+		}
+	}
+
+	field("scheme", w.Scheme, g.Scheme)
+	field("userinfo", w.User.String(), g.User.String())
+	field("host", w.Hostname(), g.Hostname())
+	field("port", w.Port(), g.Port())
+	field("opaque", w.Opaque, g.Opaque)
+	field("path", w.EscapedPath(), g.EscapedPath())
+
+	wq, gq := w.Query(), g.Query()
+	keys := make([]string, 0, len(wq)+len(gq))
+	for k := range wq {
+		keys = append(keys, k)
+	}
+	for k := range gq {
+		if _, ok := wq[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	queryDiffers := false
+	for _, k := range keys {
+		wv, wok := wq[k]
+		gv, gok := gq[k]
+		switch {
+		case !gok:
+			lines = append(lines, fmt.Sprintf("query[%q]: want %q, got missing", k, wv))
+		case !wok:
+			lines = append(lines, fmt.Sprintf("query[%q]: want missing, got %q", k, gv))
+		case !slices.Equal(wv, gv):
+			lines = append(lines, fmt.Sprintf("query[%q]: want %q, got %q", k, wv, gv))
+		default:
+			continue
+		}
+		queryDiffers = true
+	}
+	if !queryDiffers && w.RawQuery != g.RawQuery {
+		lines = append(lines, fmt.Sprintf("query order: want %q, got %q", w.RawQuery, g.RawQuery))
+	}
+
+	field("fragment", w.EscapedFragment(), g.EscapedFragment())
+
+	return lines
+}
+
+// lowerHost lowercases host except for the zone of an IPv6 literal such as
+// "[fe80::1%eth0]", which names an interface and is kept as written.
+func lowerHost(host string) string {
+	if strings.HasPrefix(host, "[") {
+		if i := strings.Index(host, "%"); i >= 0 {
+			return strings.ToLower(host[:i]) + host[i:]
+		}
+	}
+	return strings.ToLower(host)
+}
+
+// queryKey returns the key portion of a raw "key=value" query pair.
+func queryKey(pair string) string {
+	k, _, _ := strings.Cut(pair, "=")
+	return k
+}
+
+// normalizeEscapes uppercases the hex digits of every percent-encoding in s
+// and decodes those that represent unreserved characters.
+func normalizeEscapes(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' || i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+			b.WriteByte(s[i])
+			continue
+		}
+		c := unhex(s[i+1])<<4 | unhex(s[i+2])
+		if isUnreserved(c) {
+			b.WriteByte(c)
+		} else {
+			b.WriteString(strings.ToUpper(s[i : i+3]))
+		}
+		i += 2
+	}
+	return b.String()
+}
+
+// removeDotSegments implements the algorithm in RFC 3986 section 5.2.4.
+func removeDotSegments(p string) string {
+	var out []string
+	in := p
+	for in != "" {
+		switch {
+		case strings.HasPrefix(in, "../"):
+			in = in[3:]
+		case strings.HasPrefix(in, "./"):
+			in = in[2:]
+		case strings.HasPrefix(in, "/./"):
+			in = in[2:]
+		case in == "/.":
+			in = "/"
+		case strings.HasPrefix(in, "/../"):
+			in = in[3:]
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "/..":
+			in = "/"
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "." || in == "..":
+			in = ""
+		default:
+			end := strings.IndexByte(in[1:], '/') + 1
+			if end == 0 {
+				end = len(in)
+			}
+			out = append(out, in[:end])
+			in = in[end:]
+		}
+	}
+	return strings.Join(out, "")
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
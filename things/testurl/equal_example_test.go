@@ -0,0 +1,19 @@
+package testurl
+
+import (
+	"fmt"
+)
+
+// ExampleNormalize demonstrates comparing URLs that differ only in form.
+func ExampleNormalize() {
+	opts := NormalizeOptions{IgnoreQueryOrder: true, IgnoreDefaultPort: true}
+
+	a := Normalize(MustParse("HTTPS://Example.com:443/a/./b?y=2&x=1"), opts)
+	b := Normalize(MustParse("https://example.com/a/b?x=1&y=2"), opts)
+
+	fmt.Println(a.String())
+	fmt.Println(a.String() == b.String())
+	// Output:
+	// https://example.com/a/b?x=1&y=2
+	// true
+}
@@ -0,0 +1,229 @@
+package testurl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		in       string
+		opts     NormalizeOptions
+		expected string
+	}{
+		{
+			"Lowercase scheme and host",
+			"HTTPS://Example.COM/Path",
+			NormalizeOptions{},
+			"https://example.com/Path",
+		},
+		{"Empty path", "https://example.com", NormalizeOptions{}, "https://example.com/"},
+		{
+			"Escape case",
+			"https://example.com/a%2fb?q=%c3%bc",
+			NormalizeOptions{},
+			"https://example.com/a%2Fb?q=%C3%BC",
+		},
+		{
+			"Unreserved escapes decoded",
+			"https://example.com/%7Euser/%41",
+			NormalizeOptions{},
+			"https://example.com/~user/A",
+		},
+		{
+			"Dot segments",
+			"https://example.com/a/./b/../c/",
+			NormalizeOptions{},
+			"https://example.com/a/c/",
+		},
+		{
+			"Leading dot-dot",
+			"https://example.com/../a",
+			NormalizeOptions{},
+			"https://example.com/a",
+		},
+		{
+			"Default port kept",
+			"https://example.com:443/",
+			NormalizeOptions{},
+			"https://example.com:443/",
+		},
+		{
+			"Default port dropped",
+			"https://example.com:443/",
+			NormalizeOptions{IgnoreDefaultPort: true},
+			"https://example.com/",
+		},
+		{
+			"Non-default port kept",
+			"http://example.com:8080/",
+			NormalizeOptions{IgnoreDefaultPort: true},
+			"http://example.com:8080/",
+		},
+		{
+			"Query order kept",
+			"https://example.com/?b=2&a=1",
+			NormalizeOptions{},
+			"https://example.com/?b=2&a=1",
+		},
+		{
+			"Query order ignored",
+			"https://example.com/?b=2&a=1&b=1",
+			NormalizeOptions{IgnoreQueryOrder: true},
+			"https://example.com/?a=1&b=2&b=1",
+		},
+		{
+			"Fragment kept",
+			"https://example.com/#Top",
+			NormalizeOptions{},
+			"https://example.com/#Top",
+		},
+		{
+			"Fragment dropped",
+			"https://example.com/#top",
+			NormalizeOptions{IgnoreFragment: true},
+			"https://example.com/",
+		},
+		{
+			"Trailing slash dropped",
+			"https://example.com/path/",
+			NormalizeOptions{IgnoreTrailingSlash: true},
+			"https://example.com/path",
+		},
+		{
+			"Root slash kept",
+			"https://example.com/",
+			NormalizeOptions{IgnoreTrailingSlash: true},
+			"https://example.com/",
+		},
+		{
+			"IPv6 zone keeps case",
+			"http://[FE80::1%25ETH0]:8080/",
+			NormalizeOptions{},
+			"http://[fe80::1%25ETH0]:8080/",
+		},
+		{
+			"Opaque untouched",
+			"mailto:User@Example.com",
+			NormalizeOptions{},
+			"mailto:User@Example.com",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := MustParse(tc.in)
+			if got := Normalize(u, tc.opts).String(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			if u.String() != MustParse(tc.in).String() {
+				t.Errorf("Expected input to be unmodified, got %q", u.String())
+			}
+		})
+	}
+}
+
+func TestNormalizeNil(t *testing.T) {
+	t.Parallel()
+	if Normalize(nil, NormalizeOptions{}) != nil {
+		t.Error("Expected nil for nil input")
+	}
+}
+
+func TestRequireEqual(t *testing.T) {
+	t.Parallel()
+	all := NormalizeOptions{
+		IgnoreQueryOrder:    true,
+		IgnoreDefaultPort:   true,
+		IgnoreFragment:      true,
+		IgnoreTrailingSlash: true,
+	}
+	tests := []struct {
+		name     string
+		want     string
+		got      string
+		opts     NormalizeOptions
+		contains []string
+	}{
+		{name: "Equal", want: "https://example.com/", got: "https://example.com/"},
+		{name: "Normalized equal", want: "HTTPS://EXAMPLE.com", got: "https://example.com/./"},
+		{
+			name: "Options equal",
+			want: "https://example.com:443/a/?x=1&y=2#f",
+			got:  "https://example.com/a?y=2&x=1",
+			opts: all,
+		},
+		{
+			name:     "Host differs",
+			want:     "https://example.com/",
+			got:      "https://example.org/",
+			contains: []string{`host: want "example.com", got "example.org"`},
+		},
+		{
+			name:     "Port differs",
+			want:     "https://example.com:8443/",
+			got:      "https://example.com/",
+			contains: []string{`port: want "8443", got ""`},
+		},
+		{
+			name:     "Userinfo differs",
+			want:     "https://alice@example.com/",
+			got:      "https://bob@example.com/",
+			contains: []string{`userinfo: want "alice", got "bob"`},
+		},
+		{
+			name:     "Path and fragment differ",
+			want:     "https://example.com/a#x",
+			got:      "https://example.com/b#y",
+			contains: []string{`path: want "/a", got "/b"`, `fragment: want "x", got "y"`},
+		},
+		{
+			name: "Query values differ",
+			want: "https://example.com/?a=1&b=2",
+			got:  "https://example.com/?a=2&c=3",
+			contains: []string{
+				`query["a"]: want ["1"], got ["2"]`,
+				`query["b"]: want ["2"], got missing`,
+				`query["c"]: want missing, got ["3"]`,
+			},
+		},
+		{
+			name:     "Query order differs",
+			want:     "https://example.com/?a=1&b=2",
+			got:      "https://example.com/?b=2&a=1",
+			contains: []string{`query order: want "a=1&b=2", got "b=2&a=1"`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			want, got := MustParse(tc.want), MustParse(tc.got)
+			msg := equalFailure(want, got, tc.opts)
+			wantFail := len(tc.contains) > 0
+			if (msg != "") != wantFail {
+				t.Fatalf("Expected failure to be %t, got message %q", wantFail, msg)
+			}
+			for _, s := range tc.contains {
+				if !strings.Contains(msg, s) {
+					t.Errorf("Expected message to contain %q, got:\n%s", s, msg)
+				}
+			}
+			if !wantFail {
+				RequireEqual(t, want, got, tc.opts)
+			}
+		})
+	}
+}
+
+func TestRequireEqualNil(t *testing.T) {
+	t.Parallel()
+
+	RequireEqual(t, nil, nil, NormalizeOptions{})
+	if msg := equalFailure(URLHTTPS(), nil, NormalizeOptions{}); msg == "" {
+		t.Error("Expected failure when got is nil")
+	}
+}
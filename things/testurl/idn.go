@@ -0,0 +1,97 @@
+package testurl
+
+import (
+	"net/url"
+)
+
+const (
+	IDNHost                = "bücher.example"
+	PunycodeHost           = "xn--bcher-kva.example"
+	HomographHost          = "pаypal.example" // The first "а" is Cyrillic U+0430.
+	HomographPunycodeHost  = "xn--pypal-4ve.example"
+	InvalidPunycodeHost    = "xn--zz.example"
+	ASCIIOnlyPunycodeHost  = "xn--abc-.example"
+	OutOfRangePunycodeHost = "xn--9999999a.example"
+)
+
+// IDNPair is the same internationalized host name in its Unicode form and its
+// ASCII (punycode) form. Code that handles hosts should treat both alike.
+type IDNPair struct {
+	// Name briefly describes the host.
+	Name string
+
+	// Unicode is the host as a user would type it.
+	Unicode string
+
+	// ASCII is the host as it appears in DNS, with each non-ASCII label
+	// punycode-encoded and prefixed with "xn--".
+	ASCII string
+}
+
+// IDNPairs returns a fresh copy of the paired internationalized hosts. All of
+// them sit under reserved top-level domains and never resolve:
+//
+//	German        "bücher.example"    "xn--bcher-kva.example"
+//	German        "münchen.example"   "xn--mnchen-3ya.example"
+//	Japanese      "例え.テスト"          "xn--r8jz45g.xn--zckzah"
+//	Russian       "пример.испытание"  "xn--e1afmkfd.xn--80akhbyknj4f"
+//	homograph     "pаypal.example"    "xn--pypal-4ve.example"
+//
+// The Japanese and Russian names are IANA's IDN test domains. The homograph
+// mixes a Cyrillic "а" into otherwise Latin text.
+func IDNPairs() []IDNPair {
+	return []IDNPair{
+		{Name: "German", Unicode: IDNHost, ASCII: PunycodeHost},
+		{Name: "German multi-byte", Unicode: "münchen.example", ASCII: "xn--mnchen-3ya.example"},
+		{Name: "Japanese", Unicode: "例え.テスト", ASCII: "xn--r8jz45g.xn--zckzah"},
+		{Name: "Russian", Unicode: "пример.испытание", ASCII: "xn--e1afmkfd.xn--80akhbyknj4f"},
+		{Name: "homograph", Unicode: HomographHost, ASCII: HomographPunycodeHost},
+	}
+}
+
+// InvalidPunycodeHosts returns hosts that look like punycode but do not
+// decode to a valid internationalized name:
+//
+//	"xn--zz.example"        the encoded label ends mid-sequence
+//	"xn--abc-.example"      decodes to plain ASCII, which IDNA forbids
+//	"xn--9999999a.example"  decodes past the last Unicode code point
+//	"xn--.example"          the encoded label is empty
+func InvalidPunycodeHosts() []string {
+	return []string{
+		InvalidPunycodeHost,
+		ASCIIOnlyPunycodeHost,
+		OutOfRangePunycodeHost,
+		"xn--.example",
+	}
+}
+
+// URLHTTPIDN returns a *url.URL for "http://b%C3%BCcher.example/". Host
+// holds the Unicode form, "bücher.example", which String() percent-encodes.
+func URLHTTPIDN() *url.URL {
+	return MustParse("http://" + IDNHost + "/")
+}
+
+// URLHTTPPunycode returns a *url.URL for "http://xn--bcher-kva.example/", the
+// ASCII form of URLHTTPIDN.
+func URLHTTPPunycode() *url.URL {
+	return MustParse("http://" + PunycodeHost + "/")
+}
+
+// URLHTTPHomograph returns a *url.URL for "http://p%D0%B0ypal.example/".
+// Host holds the Unicode form, "pаypal.example", where the first "а" is
+// Cyrillic, and String() percent-encodes it.
+func URLHTTPHomograph() *url.URL {
+	return MustParse("http://" + HomographHost + "/")
+}
+
+// URLHTTPHomographPunycode returns a *url.URL for
+// "http://xn--pypal-4ve.example/", the ASCII form of URLHTTPHomograph.
+func URLHTTPHomographPunycode() *url.URL {
+	return MustParse("http://" + HomographPunycodeHost + "/")
+}
+
+// URLHTTPInvalidPunycode returns a *url.URL for "http://xn--zz.example/". It
+// parses, but the punycode label cannot be decoded.
+func URLHTTPInvalidPunycode() *url.URL {
+	return MustParse("http://" + InvalidPunycodeHost + "/")
+}
@@ -0,0 +1,43 @@
+package testurl
+
+import (
+	"fmt"
+)
+
+func ExampleURLHTTPIDN() {
+	fmt.Println(URLHTTPIDN().Hostname())
+	fmt.Println(URLHTTPIDN().String())
+	// Output:
+	// bücher.example
+	// http://b%C3%BCcher.example/
+}
+
+func ExampleURLHTTPPunycode() {
+	fmt.Println(URLHTTPPunycode().String())
+	// Output: http://xn--bcher-kva.example/
+}
+
+func ExampleURLHTTPHomograph() {
+	fmt.Println(URLHTTPHomograph().String())
+	// Output: http://p%D0%B0ypal.example/
+}
+
+func ExampleURLHTTPHomographPunycode() {
+	fmt.Println(URLHTTPHomographPunycode().String())
+	// Output: http://xn--pypal-4ve.example/
+}
+
+func ExampleURLHTTPInvalidPunycode() {
+	fmt.Println(URLHTTPInvalidPunycode().String())
+	// Output: http://xn--zz.example/
+}
+
+// ExampleIDNPairs demonstrates checking that both forms of a host are treated alike.
+func ExampleIDNPairs() {
+	for _, p := range IDNPairs()[:2] {
+		fmt.Println(p.Unicode, "=", p.ASCII)
+	}
+	// Output:
+	// bücher.example = xn--bcher-kva.example
+	// münchen.example = xn--mnchen-3ya.example
+}
@@ -0,0 +1,111 @@
+package testurl
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestIDNPairs compares the catalog with literal expectations. The ASCII
+// forms match golang.org/x/net/idna's Lookup.ToASCII.
+func TestIDNPairs(t *testing.T) {
+	t.Parallel()
+	expected := [][2]string{
+		{"bücher.example", "xn--bcher-kva.example"},
+		{"münchen.example", "xn--mnchen-3ya.example"},
+		{"例え.テスト", "xn--r8jz45g.xn--zckzah"},
+		{"пример.испытание", "xn--e1afmkfd.xn--80akhbyknj4f"},
+		{"pаypal.example", "xn--pypal-4ve.example"},
+	}
+
+	pairs := IDNPairs()
+	if len(pairs) != len(expected) {
+		t.Fatalf("Expected %d pairs, got %d", len(expected), len(pairs))
+	}
+	for i, tc := range pairs {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if tc.Unicode != expected[i][0] || tc.ASCII != expected[i][1] {
+				t.Errorf("Expected %q, got %q", expected[i], [2]string{tc.Unicode, tc.ASCII})
+			}
+			if !strings.Contains(tc.ASCII, "xn--") {
+				t.Errorf("Expected %q to hold a punycode label", tc.ASCII)
+			}
+
+			for _, host := range []string{tc.Unicode, tc.ASCII} {
+				u := MustParse("http://" + host + "/")
+				if u.Hostname() != host {
+					t.Errorf("Expected hostname %q, got %q", host, u.Hostname())
+				}
+				if p := MustParse(u.String()); p.Hostname() != host {
+					t.Errorf("Expected %q to round-trip, got %q", host, p.Hostname())
+				}
+			}
+		})
+	}
+}
+
+func TestInvalidPunycodeHosts(t *testing.T) {
+	t.Parallel()
+	expected := []string{
+		"xn--zz.example",
+		"xn--abc-.example",
+		"xn--9999999a.example",
+		"xn--.example",
+	}
+
+	hosts := InvalidPunycodeHosts()
+	if !slices.Equal(hosts, expected) {
+		t.Fatalf("Expected %q, got %q", expected, hosts)
+	}
+	for _, host := range hosts {
+		if _, err := url.Parse("http://" + host + "/"); err != nil {
+			t.Errorf("Expected %q to parse as a URL, got %v", host, err)
+		}
+	}
+}
+
+func TestIDNURLFunctions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fn       func() *url.URL
+		host     string
+		expected string
+	}{
+		{"URLHTTPIDN", URLHTTPIDN, "bücher.example", "http://b%C3%BCcher.example/"},
+		{
+			"URLHTTPPunycode",
+			URLHTTPPunycode,
+			"xn--bcher-kva.example",
+			"http://xn--bcher-kva.example/",
+		},
+		{"URLHTTPHomograph", URLHTTPHomograph, "pаypal.example", "http://p%D0%B0ypal.example/"},
+		{
+			"URLHTTPHomographPunycode",
+			URLHTTPHomographPunycode,
+			"xn--pypal-4ve.example",
+			"http://xn--pypal-4ve.example/",
+		},
+		{
+			"URLHTTPInvalidPunycode",
+			URLHTTPInvalidPunycode,
+			"xn--zz.example",
+			"http://xn--zz.example/",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := tc.fn()
+			if u.Host != tc.host {
+				t.Errorf("Expected host %q, got %q", tc.host, u.Host)
+			}
+			if u.String() != tc.expected {
+				t.Errorf("Expected %s to be %q, got %q", tc.name, tc.expected, u.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Package catalog holds the lookups shared by the ssrf, openredirect, and
+// traversal payload catalogs.
+package catalog
+
+// Strings returns raw(p) for every payload in payloads, in order.
+func Strings[P any](payloads []P, raw func(P) string) []string {
+	out := make([]string, 0, len(payloads))
+	for _, p := range payloads {
+		out = append(out, raw(p))
+	}
+	return out
+}
+
+// ByClass returns the payloads whose class(p) is any of classes, in order.
+func ByClass[P any, C comparable](payloads []P, class func(P) C, classes []C) []P {
+	var out []P
+	for _, p := range payloads {
+		for _, c := range classes {
+			if class(p) == c {
+				out = append(out, p)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+package testurl
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// InvalidString is a raw URL string that net/url refuses to parse, along with
+// the error each parser is expected to return.
+type InvalidString struct {
+	// Name briefly describes what is wrong with Input.
+	Name string
+
+	// Input is the raw string to hand to the parser.
+	Input string
+
+	// ParseErr is a substring of the error url.Parse returns for Input. It is
+	// empty when url.Parse accepts Input.
+	ParseErr string
+
+	// RequestURIErr is a substring of the error url.ParseRequestURI returns for
+	// Input. It is empty when url.ParseRequestURI accepts Input.
+	RequestURIErr string
+}
+
+// InvalidStrings returns a fresh copy of the invalid URL string corpus. Every
+// entry is rejected by url.Parse, url.ParseRequestURI, or both:
+//
+//	bad percent-escape            "http://example.com/%zz"
+//	truncated percent-escape      "http://example.com/%4"
+//	invalid escape in host        "http://%zz/"
+//	control character             "http://example.com/\x7f"
+//	newline                       "http://example.com/\n"
+//	null byte                     "http://example.com/\x00"
+//	invalid port                  "http://example.com:port/"
+//	invalid port after IPv6       "http://[::1]:abc/"
+//	garbage after IPv6            "http://[::1]x/"
+//	missing IPv6 bracket          "http://[::1/"
+//	unescaped IPv6 zone           "http://[fe80::1%eth0]/"
+//	space in host                 "http://exa mple.com/"
+//	space in userinfo             "http://us er@example.com/"
+//	missing scheme                "://example.com"
+//	colon in first path segment   "1path:segment"
+//	space in scheme               "ht tp://example.com"
+//	relative reference            "path/to/resource"
+//	empty string                  ""
+//
+// The last two are accepted by url.Parse and rejected only by
+// url.ParseRequestURI.
+func InvalidStrings() []InvalidString {
+	return []InvalidString{
+		{
+			Name:          "bad percent-escape",
+			Input:         "http://" + ExampleHost + "/%zz",
+			ParseErr:      `invalid URL escape "%zz"`,
+			RequestURIErr: `invalid URL escape "%zz"`,
+		},
+		{
+			Name:          "truncated percent-escape",
+			Input:         "http://" + ExampleHost + "/%4",
+			ParseErr:      `invalid URL escape "%4"`,
+			RequestURIErr: `invalid URL escape "%4"`,
+		},
+		{
+			Name:          "invalid escape in host",
+			Input:         "http://%zz/",
+			ParseErr:      `invalid URL escape "%zz"`,
+			RequestURIErr: `invalid URL escape "%zz"`,
+		},
+		{
+			Name:          "control character",
+			Input:         "http://" + ExampleHost + "/\x7f",
+			ParseErr:      "invalid control character in URL",
+			RequestURIErr: "invalid control character in URL",
+		},
+		{
+			Name:          "newline",
+			Input:         "http://" + ExampleHost + "/\n",
+			ParseErr:      "invalid control character in URL",
+			RequestURIErr: "invalid control character in URL",
+		},
+		{
+			Name:          "null byte",
+			Input:         "http://" + ExampleHost + "/\x00",
+			ParseErr:      "invalid control character in URL",
+			RequestURIErr: "invalid control character in URL",
+		},
+		{
+			Name:          "invalid port",
+			Input:         "http://" + ExampleHost + ":port/",
+			ParseErr:      `invalid port ":port" after host`,
+			RequestURIErr: `invalid port ":port" after host`,
+		},
+		{
+			Name:          "invalid port after IPv6",
+			Input:         "http://[::1]:abc/",
+			ParseErr:      `invalid port ":abc" after host`,
+			RequestURIErr: `invalid port ":abc" after host`,
+		},
+		{
+			Name:          "garbage after IPv6",
+			Input:         "http://[::1]x/",
+			ParseErr:      `invalid port "x" after host`,
+			RequestURIErr: `invalid port "x" after host`,
+		},
+		{
+			Name:          "missing IPv6 bracket",
+			Input:         "http://[::1/",
+			ParseErr:      "missing ']' in host",
+			RequestURIErr: "missing ']' in host",
+		},
+		{
+			Name:          "unescaped IPv6 zone",
+			Input:         "http://[fe80::1%eth0]/",
+			ParseErr:      `invalid URL escape "%et"`,
+			RequestURIErr: `invalid URL escape "%et"`,
+		},
+		{
+			Name:          "space in host",
+			Input:         "http://exa mple.com/",
+			ParseErr:      `invalid character " " in host name`,
+			RequestURIErr: `invalid character " " in host name`,
+		},
+		{
+			Name:          "space in userinfo",
+			Input:         "http://us er@" + ExampleHost + "/",
+			ParseErr:      "invalid userinfo",
+			RequestURIErr: "invalid userinfo",
+		},
+		{
+			Name:          "missing scheme",
+			Input:         "://" + ExampleHost,
+			ParseErr:      "missing protocol scheme",
+			RequestURIErr: "missing protocol scheme",
+		},
+		{
+			Name:          "colon in first path segment",
+			Input:         "1path:segment",
+			ParseErr:      "first path segment in URL cannot contain colon",
+			RequestURIErr: "invalid URI for request",
+		},
+		{
+			Name:          "space in scheme",
+			Input:         "ht tp://" + ExampleHost,
+			ParseErr:      "first path segment in URL cannot contain colon",
+			RequestURIErr: "invalid URI for request",
+		},
+		{
+			Name:          "relative reference",
+			Input:         "path/to/resource",
+			RequestURIErr: "invalid URI for request",
+		},
+		{
+			Name:          "empty string",
+			Input:         "",
+			RequestURIErr: "empty url",
+		},
+	}
+}
+
+// MustFailParse is a helper function that checks an InvalidString against
+// url.Parse and url.ParseRequestURI and panics if either parser disagrees with
+// the entry. It returns the url.Parse error, or the url.ParseRequestURI error
+// when url.Parse is expected to succeed.
+func MustFailParse(s InvalidString) error {
+	parseErr := checkParse("url.Parse", s.Input, s.ParseErr, func(in string) error {
+		_, err := url.Parse(in)
+		return err
+	})
+	requestErr := checkParse(
+		"url.ParseRequestURI",
+		s.Input,
+		s.RequestURIErr,
+		func(in string) error {
+			_, err := url.ParseRequestURI(in)
+			return err
+		},
+	)
+
+	if parseErr != nil {
+		return parseErr
+	}
+	return requestErr
+}
+
+// checkParse runs parse on input and panics unless the outcome matches want,
+// where an empty want means parse must succeed.
+func checkParse(name, input, want string, parse func(string) error) error {
+	err := parse(input)
+	switch {
+	case want == "" && err != nil:
+		panic(fmt.Sprintf("expected %s to accept %q, got %v", name, input, err))
+	case want != "" && err == nil:
+		panic(fmt.Sprintf("expected %s to reject %q", name, input))
+	case want != "" && !strings.Contains(err.Error(), want):
+		panic(fmt.Sprintf("expected %s error for %q to contain %q, got %v", name, input, want, err))
+	}
+	return err
+}
@@ -0,0 +1,30 @@
+package testurl
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ExampleInvalidStrings demonstrates feeding the corpus to url.Parse.
+func ExampleInvalidStrings() {
+	for _, s := range InvalidStrings()[:3] {
+		_, err := url.Parse(s.Input)
+		fmt.Println(s.Name, "->", err != nil)
+	}
+	// Output:
+	// bad percent-escape -> true
+	// truncated percent-escape -> true
+	// invalid escape in host -> true
+}
+
+// ExampleMustFailParse demonstrates verifying a single corpus entry.
+func ExampleMustFailParse() {
+	err := MustFailParse(InvalidString{
+		Name:          "invalid port",
+		Input:         "http://example.com:port/",
+		ParseErr:      "invalid port",
+		RequestURIErr: "invalid port",
+	})
+	fmt.Println(err)
+	// Output: parse "http://example.com:port/": invalid port ":port" after host
+}
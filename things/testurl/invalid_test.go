@@ -0,0 +1,88 @@
+package testurl
+
+import (
+	"testing"
+)
+
+func TestInvalidStrings(t *testing.T) {
+	t.Parallel()
+
+	entries := InvalidStrings()
+	if len(entries) == 0 {
+		t.Fatal("Expected a non-empty corpus")
+	}
+
+	seen := make(map[string]bool)
+	for _, tc := range entries {
+		if seen[tc.Name] {
+			t.Fatalf("Duplicate entry name %q", tc.Name)
+		}
+		seen[tc.Name] = true
+
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			if tc.ParseErr == "" && tc.RequestURIErr == "" {
+				t.Fatal("Expected at least one parser to fail")
+			}
+			if err := MustFailParse(tc); err == nil {
+				t.Error("Expected MustFailParse to return the parse error")
+			}
+		})
+	}
+}
+
+func TestInvalidStringsReturnsCopy(t *testing.T) {
+	t.Parallel()
+
+	first := InvalidStrings()
+	first[0].Input = "changed"
+	if InvalidStrings()[0].Input == "changed" {
+		t.Error("Expected each call to return a fresh corpus")
+	}
+}
+
+func TestMustFailParse(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name  string
+		entry InvalidString
+		panic bool
+	}{
+		{
+			name: "Matching entry",
+			entry: InvalidString{
+				Input:         "http://%zz/",
+				ParseErr:      "invalid URL escape",
+				RequestURIErr: "invalid URL escape",
+			},
+			panic: false,
+		},
+		{
+			name:  "Parse unexpectedly succeeds",
+			entry: InvalidString{Input: "http://example.com/", ParseErr: "anything"},
+			panic: true,
+		},
+		{
+			name:  "Parse unexpectedly fails",
+			entry: InvalidString{Input: "", ParseErr: "", RequestURIErr: ""},
+			panic: true,
+		},
+		{
+			name:  "Error mismatch",
+			entry: InvalidString{Input: "http://%zz/", ParseErr: "missing protocol scheme"},
+			panic: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer func() {
+				if r := recover(); (r != nil) != tc.panic {
+					t.Errorf("Expected panic to be %t, got %v", tc.panic, r)
+				}
+			}()
+			MustFailParse(tc.entry)
+		})
+	}
+}
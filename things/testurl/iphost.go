@@ -0,0 +1,85 @@
+package testurl
+
+import (
+	"net/url"
+)
+
+const (
+	IPv4LoopbackHost  = "127.0.0.1"
+	IPv6LoopbackHost  = "::1"
+	IPv6Host          = "2001:db8::1"
+	IPv6LinkLocalHost = "fe80::1"
+	IPv6Zone          = "eth0"
+	IPv4MappedHost    = "::ffff:127.0.0.1"
+)
+
+// URLHTTPIPv4 returns a *url.URL for "http://127.0.0.1/".
+func URLHTTPIPv4() *url.URL {
+	return MustParse("http://" + IPv4LoopbackHost + "/")
+}
+
+// URLHTTPIPv4WithPort returns a *url.URL for "http://127.0.0.1:8080/".
+func URLHTTPIPv4WithPort() *url.URL {
+	return MustParse("http://" + IPv4LoopbackHost + ":" + HTTPPort + "/")
+}
+
+// URLHTTPIPv6 returns a *url.URL for "http://[2001:db8::1]/".
+func URLHTTPIPv6() *url.URL {
+	return MustParse("http://[" + IPv6Host + "]/")
+}
+
+// URLHTTPIPv6WithPort returns a *url.URL for "http://[2001:db8::1]:8080/".
+func URLHTTPIPv6WithPort() *url.URL {
+	return MustParse("http://[" + IPv6Host + "]:" + HTTPPort + "/")
+}
+
+// URLHTTPIPv6Loopback returns a *url.URL for "http://[::1]/".
+func URLHTTPIPv6Loopback() *url.URL {
+	return MustParse("http://[" + IPv6LoopbackHost + "]/")
+}
+
+// URLHTTPIPv6LoopbackWithPort returns a *url.URL for "http://[::1]:8080/".
+func URLHTTPIPv6LoopbackWithPort() *url.URL {
+	return MustParse("http://[" + IPv6LoopbackHost + "]:" + HTTPPort + "/")
+}
+
+// URLHTTPIPv6Zone returns a *url.URL for "http://[fe80::1%25eth0]/". The zone
+// separator is percent-encoded in the string form, while Hostname reports
+// "fe80::1%eth0".
+func URLHTTPIPv6Zone() *url.URL {
+	return MustParse("http://[" + IPv6LinkLocalHost + "%25" + IPv6Zone + "]/")
+}
+
+// URLHTTPIPv6ZoneWithPort returns a *url.URL for "http://[fe80::1%25eth0]:8080/".
+func URLHTTPIPv6ZoneWithPort() *url.URL {
+	return MustParse("http://[" + IPv6LinkLocalHost + "%25" + IPv6Zone + "]:" + HTTPPort + "/")
+}
+
+// URLHTTPIPv4Mapped returns a *url.URL for "http://[::ffff:127.0.0.1]/", an
+// IPv4 address expressed as an IPv4-mapped IPv6 address.
+func URLHTTPIPv4Mapped() *url.URL {
+	return MustParse("http://[" + IPv4MappedHost + "]/")
+}
+
+// URLHTTPIPv6Unbracketed returns a *url.URL for "http://2001:db8::1". Since Go
+// 1.26, url.Parse rejects this string unless GODEBUG urlstrictcolons=0 is set,
+// which is the default for modules declaring an older go version. On the
+// returned value, Hostname reports "2001:db8:" and Port reports "1".
+func URLHTTPIPv6Unbracketed() *url.URL {
+	return MalformedURL("http", IPv6Host)
+}
+
+// URLHTTPIPv6UnbracketedWithPort returns a *url.URL for
+// "http://2001:db8::1:8080". Hostname reports "2001:db8::1" and Port reports
+// "8080", but only because the split happens at the last colon, the same rule
+// that mangles URLHTTPIPv6Unbracketed. url.Parse behaves as it does for
+// URLHTTPIPv6Unbracketed.
+func URLHTTPIPv6UnbracketedWithPort() *url.URL {
+	return MalformedURL("http", IPv6Host+":"+HTTPPort)
+}
+
+// URLHTTPIPv6MissingBracket returns a *url.URL for "http://[::1". url.Parse
+// rejects this string because the closing bracket is missing.
+func URLHTTPIPv6MissingBracket() *url.URL {
+	return MalformedURL("http", "["+IPv6LoopbackHost)
+}
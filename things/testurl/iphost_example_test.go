@@ -0,0 +1,65 @@
+package testurl
+
+import (
+	"fmt"
+)
+
+func ExampleURLHTTPIPv4() {
+	fmt.Println(URLHTTPIPv4().String())
+	// Output: http://127.0.0.1/
+}
+
+func ExampleURLHTTPIPv4WithPort() {
+	fmt.Println(URLHTTPIPv4WithPort().String())
+	// Output: http://127.0.0.1:8080/
+}
+
+func ExampleURLHTTPIPv6() {
+	fmt.Println(URLHTTPIPv6().String())
+	// Output: http://[2001:db8::1]/
+}
+
+func ExampleURLHTTPIPv6WithPort() {
+	fmt.Println(URLHTTPIPv6WithPort().String())
+	// Output: http://[2001:db8::1]:8080/
+}
+
+func ExampleURLHTTPIPv6Loopback() {
+	fmt.Println(URLHTTPIPv6Loopback().String())
+	// Output: http://[::1]/
+}
+
+func ExampleURLHTTPIPv6LoopbackWithPort() {
+	fmt.Println(URLHTTPIPv6LoopbackWithPort().String())
+	// Output: http://[::1]:8080/
+}
+
+func ExampleURLHTTPIPv6Zone() {
+	fmt.Println(URLHTTPIPv6Zone().String())
+	// Output: http://[fe80::1%25eth0]/
+}
+
+func ExampleURLHTTPIPv6ZoneWithPort() {
+	fmt.Println(URLHTTPIPv6ZoneWithPort().String())
+	// Output: http://[fe80::1%25eth0]:8080/
+}
+
+func ExampleURLHTTPIPv4Mapped() {
+	fmt.Println(URLHTTPIPv4Mapped().String())
+	// Output: http://[::ffff:127.0.0.1]/
+}
+
+func ExampleURLHTTPIPv6Unbracketed() {
+	fmt.Println(URLHTTPIPv6Unbracketed().String())
+	// Output: http://2001:db8::1
+}
+
+func ExampleURLHTTPIPv6UnbracketedWithPort() {
+	fmt.Println(URLHTTPIPv6UnbracketedWithPort().String())
+	// Output: http://2001:db8::1:8080
+}
+
+func ExampleURLHTTPIPv6MissingBracket() {
+	fmt.Println(URLHTTPIPv6MissingBracket().String())
+	// Output: http://[::1
+}
@@ -0,0 +1,92 @@
+package testurl
+
+import (
+	"net/url"
+	"testing"
+)
+
+// TestIPHostURLFunctions verifies all IP-literal URL functions return the expected URL string.
+func TestIPHostURLFunctions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fn       func() *url.URL
+		expected string
+	}{
+		{"URLHTTPIPv4", URLHTTPIPv4, "http://127.0.0.1/"},
+		{"URLHTTPIPv4WithPort", URLHTTPIPv4WithPort, "http://127.0.0.1:8080/"},
+		{"URLHTTPIPv6", URLHTTPIPv6, "http://[2001:db8::1]/"},
+		{"URLHTTPIPv6WithPort", URLHTTPIPv6WithPort, "http://[2001:db8::1]:8080/"},
+		{"URLHTTPIPv6Loopback", URLHTTPIPv6Loopback, "http://[::1]/"},
+		{"URLHTTPIPv6LoopbackWithPort", URLHTTPIPv6LoopbackWithPort, "http://[::1]:8080/"},
+		{"URLHTTPIPv6Zone", URLHTTPIPv6Zone, "http://[fe80::1%25eth0]/"},
+		{"URLHTTPIPv6ZoneWithPort", URLHTTPIPv6ZoneWithPort, "http://[fe80::1%25eth0]:8080/"},
+		{"URLHTTPIPv4Mapped", URLHTTPIPv4Mapped, "http://[::ffff:127.0.0.1]/"},
+		{"URLHTTPIPv6Unbracketed", URLHTTPIPv6Unbracketed, "http://2001:db8::1"},
+		{
+			"URLHTTPIPv6UnbracketedWithPort",
+			URLHTTPIPv6UnbracketedWithPort,
+			"http://2001:db8::1:8080",
+		},
+		{"URLHTTPIPv6MissingBracket", URLHTTPIPv6MissingBracket, "http://[::1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := tc.fn()
+			if u.String() != tc.expected {
+				t.Errorf("Expected %s to be %q, got %q", tc.name, tc.expected, u.String())
+			}
+		})
+	}
+}
+
+// TestIPHostComponents verifies how net/url splits each IP-literal host.
+func TestIPHostComponents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fn       func() *url.URL
+		hostname string
+		port     string
+	}{
+		{"URLHTTPIPv4WithPort", URLHTTPIPv4WithPort, "127.0.0.1", "8080"},
+		{"URLHTTPIPv6WithPort", URLHTTPIPv6WithPort, "2001:db8::1", "8080"},
+		{"URLHTTPIPv6Loopback", URLHTTPIPv6Loopback, "::1", ""},
+		{"URLHTTPIPv6ZoneWithPort", URLHTTPIPv6ZoneWithPort, "fe80::1%eth0", "8080"},
+		{"URLHTTPIPv4Mapped", URLHTTPIPv4Mapped, "::ffff:127.0.0.1", ""},
+		{"URLHTTPIPv6Unbracketed", URLHTTPIPv6Unbracketed, "2001:db8:", "1"},
+		{
+			"URLHTTPIPv6UnbracketedWithPort",
+			URLHTTPIPv6UnbracketedWithPort,
+			"2001:db8::1",
+			"8080",
+		},
+		{"URLHTTPIPv6MissingBracket", URLHTTPIPv6MissingBracket, "[:", "1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := tc.fn()
+			if u.Hostname() != tc.hostname || u.Port() != tc.port {
+				t.Errorf(
+					"Expected hostname %q and port %q, got %q and %q",
+					tc.hostname,
+					tc.port,
+					u.Hostname(),
+					u.Port(),
+				)
+			}
+		})
+	}
+}
+
+// TestIPHostMissingBracketFailsParse verifies the unterminated IPv6 literal is rejected by url.Parse.
+func TestIPHostMissingBracketFailsParse(t *testing.T) {
+	t.Parallel()
+	if _, err := url.Parse(URLHTTPIPv6MissingBracket().String()); err == nil {
+		t.Error("Expected url.Parse to reject a missing closing bracket")
+	}
+}
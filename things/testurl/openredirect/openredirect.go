@@ -0,0 +1,164 @@
+/*
+Package openredirect catalogs open-redirect payloads: values an attacker puts
+in a "next" or "return_to" parameter to send a user from a trusted login or
+logout page to a site they control.
+
+	github.com/madflojo/testlazy/things/testurl/openredirect
+
+Every Payload is tagged with the Class of trick it uses. Raw is the exact
+string to place in the parameter and URL returns it parsed, so redirect
+handlers can be tested with either form.
+
+Example usage
+
+	for _, p := range openredirect.Payloads() {
+	    t.Run(p.Name, func(t *testing.T) {
+	        if safeRedirect(p.Raw) {
+	            t.Errorf("%s payload %q was accepted", p.Class, p.Raw)
+	        }
+	    })
+	}
+
+Malicious targets use the reserved evil.example domain, which never resolves.
+*/
+package openredirect
+
+import (
+	"net/url"
+
+	"github.com/madflojo/testlazy/things/testurl"
+	"github.com/madflojo/testlazy/things/testurl/internal/catalog"
+)
+
+// EvilHost is the attacker-controlled host every payload tries to reach.
+const EvilHost = "evil.example"
+
+// Class identifies the technique a Payload uses to escape the trusted site.
+type Class string
+
+const (
+	// ClassAbsolute is a plain absolute URL on another host.
+	ClassAbsolute Class = "absolute"
+
+	// ClassProtocolRelative starts with two or more slashes, which browsers
+	// treat as a new host.
+	ClassProtocolRelative Class = "protocol-relative"
+
+	// ClassBackslash uses backslashes, which browsers normalize to slashes.
+	ClassBackslash Class = "backslash"
+
+	// ClassScheme uses a script-capable scheme such as javascript: or data:.
+	ClassScheme Class = "scheme"
+
+	// ClassEncodedSlash hides the leading slashes behind percent-encoding.
+	ClassEncodedSlash Class = "encoded-slash"
+
+	// ClassWhitespace relies on browsers stripping tabs and newlines.
+	ClassWhitespace Class = "whitespace"
+
+	// ClassLookalike mentions the trusted host without actually being on it.
+	ClassLookalike Class = "lookalike"
+)
+
+// Payload is a single open-redirect test input.
+type Payload struct {
+	// Name briefly describes the payload.
+	Name string
+
+	// Raw is the redirect target exactly as an attacker would supply it.
+	Raw string
+
+	// Class is the technique the payload uses.
+	Class Class
+}
+
+// URL returns a new *url.URL parsed from Raw. Every payload parses, but
+// net/url lowercases schemes and escapes backslashes, so URL().String() may
+// not equal Raw.
+func (p Payload) URL() *url.URL {
+	return testurl.MustParse(p.Raw)
+}
+
+// Payloads returns a fresh copy of the full catalog.
+func Payloads() []Payload {
+	return []Payload{
+		{Name: "absolute https", Raw: "https://" + EvilHost, Class: ClassAbsolute},
+		{
+			Name:  "absolute http with path",
+			Raw:   "http://" + EvilHost + "/login",
+			Class: ClassAbsolute,
+		},
+
+		{Name: "protocol-relative", Raw: "//" + EvilHost, Class: ClassProtocolRelative},
+		{Name: "triple slash", Raw: "///" + EvilHost, Class: ClassProtocolRelative},
+		{Name: "quadruple slash", Raw: "////" + EvilHost, Class: ClassProtocolRelative},
+
+		{Name: "slash backslash", Raw: `/\` + EvilHost, Class: ClassBackslash},
+		{Name: "double backslash", Raw: `\\` + EvilHost, Class: ClassBackslash},
+		{Name: "slash backslash slash", Raw: `/\/` + EvilHost, Class: ClassBackslash},
+		{Name: "scheme with backslash", Raw: `https:/\` + EvilHost, Class: ClassBackslash},
+
+		{Name: "javascript", Raw: "javascript:alert(1)", Class: ClassScheme},
+		{Name: "javascript mixed case", Raw: "JaVaScRiPt:alert(1)", Class: ClassScheme},
+		{Name: "javascript encoded newline", Raw: "javascript:%0aalert(1)", Class: ClassScheme},
+		{Name: "data html", Raw: "data:text/html,<script>alert(1)</script>", Class: ClassScheme},
+		{Name: "vbscript", Raw: "vbscript:msgbox(1)", Class: ClassScheme},
+
+		{Name: "encoded double slash", Raw: "/%2F%2F" + EvilHost, Class: ClassEncodedSlash},
+		{Name: "encoded lowercase slash", Raw: "/%2f%2f" + EvilHost, Class: ClassEncodedSlash},
+		{Name: "encoded leading slashes", Raw: "%2F%2F" + EvilHost, Class: ClassEncodedSlash},
+		{Name: "encoded backslash", Raw: "/%5C" + EvilHost, Class: ClassEncodedSlash},
+
+		{Name: "encoded tab", Raw: "/%09/" + EvilHost, Class: ClassWhitespace},
+		{Name: "encoded newline", Raw: "/%0a/" + EvilHost, Class: ClassWhitespace},
+
+		{
+			Name:  "userinfo",
+			Raw:   "https://" + testurl.ExampleHost + "@" + EvilHost,
+			Class: ClassLookalike,
+		},
+		{
+			Name:  "subdomain",
+			Raw:   "https://" + testurl.ExampleHost + "." + EvilHost,
+			Class: ClassLookalike,
+		},
+		{
+			Name:  "trusted host in path",
+			Raw:   "https://" + EvilHost + "/" + testurl.ExampleHost,
+			Class: ClassLookalike,
+		},
+		{
+			Name:  "trusted host in query",
+			Raw:   "https://" + EvilHost + "?" + testurl.ExampleHost,
+			Class: ClassLookalike,
+		},
+		{
+			Name:  "trusted host in fragment",
+			Raw:   "https://" + EvilHost + "#" + testurl.ExampleHost,
+			Class: ClassLookalike,
+		},
+	}
+}
+
+// Strings returns the Raw form of every payload.
+func Strings() []string {
+	return catalog.Strings(Payloads(), func(p Payload) string { return p.Raw })
+}
+
+// ByClass returns the payloads tagged with any of the given classes.
+func ByClass(classes ...Class) []Payload {
+	return catalog.ByClass(Payloads(), func(p Payload) Class { return p.Class }, classes)
+}
+
+// Classes returns every Class used in the catalog, in catalog order.
+func Classes() []Class {
+	return []Class{
+		ClassAbsolute,
+		ClassProtocolRelative,
+		ClassBackslash,
+		ClassScheme,
+		ClassEncodedSlash,
+		ClassWhitespace,
+		ClassLookalike,
+	}
+}
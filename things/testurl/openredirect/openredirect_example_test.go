@@ -0,0 +1,17 @@
+package openredirect_test
+
+import (
+	"fmt"
+
+	"github.com/madflojo/testlazy/things/testurl/openredirect"
+)
+
+func ExampleByClass() {
+	for _, p := range openredirect.ByClass(openredirect.ClassProtocolRelative) {
+		fmt.Println(p.Raw)
+	}
+	// Output:
+	// //evil.example
+	// ///evil.example
+	// ////evil.example
+}
@@ -0,0 +1,107 @@
+package openredirect
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestPayloads(t *testing.T) {
+	t.Parallel()
+
+	known := make(map[Class]bool)
+	for _, c := range Classes() {
+		known[c] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range Payloads() {
+		if seen[p.Name] {
+			t.Fatalf("Duplicate payload name %q", p.Name)
+		}
+		seen[p.Name] = true
+
+		t.Run(p.Name, func(t *testing.T) {
+			t.Parallel()
+			if !known[p.Class] {
+				t.Errorf("Unknown class %q", p.Class)
+			}
+			if p.URL() == nil {
+				t.Fatal("Expected a parsed URL")
+			}
+			if !leavesTrustedSite(p.Raw) {
+				t.Errorf("Expected %q to leave the trusted site", p.Raw)
+			}
+		})
+	}
+}
+
+func TestEveryClassHasPayloads(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range Classes() {
+		if len(ByClass(c)) == 0 {
+			t.Errorf("Expected payloads for class %q", c)
+		}
+	}
+}
+
+func TestByClass(t *testing.T) {
+	t.Parallel()
+
+	got := ByClass(ClassScheme, ClassBackslash)
+	want := len(ByClass(ClassScheme)) + len(ByClass(ClassBackslash))
+	if len(got) != want {
+		t.Fatalf("Expected %d payloads, got %d", want, len(got))
+	}
+	if len(ByClass()) != 0 {
+		t.Error("Expected no payloads without a class")
+	}
+}
+
+func TestStrings(t *testing.T) {
+	t.Parallel()
+
+	payloads := Payloads()
+	strs := Strings()
+	if len(strs) != len(payloads) {
+		t.Fatalf("Expected %d strings, got %d", len(payloads), len(strs))
+	}
+	for i, s := range strs {
+		if s != payloads[i].Raw {
+			t.Errorf("Expected %q at %d, got %q", payloads[i].Raw, i, s)
+		}
+	}
+}
+
+// leavesTrustedSite reports whether a browser following a redirect to raw
+// from a page on example.com would end up somewhere else.
+func leavesTrustedSite(raw string) bool {
+	// Browsers decode the redirect target, drop tabs and newlines, and treat
+	// backslashes as slashes.
+	s, err := url.PathUnescape(raw)
+	if err != nil {
+		return false
+	}
+	s = strings.NewReplacer("\t", "", "\n", "", `\`, "/").Replace(s)
+
+	// Any run of two or more leading slashes starts an authority.
+	if strings.HasPrefix(s, "//") {
+		s = "//" + strings.TrimLeft(s, "/")
+	}
+	if i := strings.Index(s, ":/"); i > 0 && !strings.Contains(s[:i], "/") {
+		s = s[:i] + "://" + strings.TrimLeft(s[i+1:], "/")
+	}
+
+	ref, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	switch ref.Scheme {
+	case "javascript", "data", "vbscript":
+		return true
+	}
+
+	base := &url.URL{Scheme: "https", Host: "example.com", Path: "/login"}
+	return base.ResolveReference(ref).Hostname() != "example.com"
+}
@@ -0,0 +1,174 @@
+package testurl
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Common limits on URL length, useful with URLHTTPWithLongQuery.
+const (
+	// URLLengthLimitBrowser is the longest URL legacy Internet Explorer accepts,
+	// and a common conservative limit elsewhere.
+	URLLengthLimitBrowser = 2083
+
+	// URLLengthLimitApache is Apache's default LimitRequestLine.
+	URLLengthLimitApache = 8190
+
+	// URLLengthLimitNginx is the size of nginx's default large header buffer.
+	URLLengthLimitNginx = 8192
+)
+
+// URLHTTPWithRepeatedQuery returns a *url.URL for "http://example.com/?a=1&a=2".
+// Query()["a"] is ["1" "2"] and Query().Get("a") is "1".
+func URLHTTPWithRepeatedQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?a=1&a=2")
+}
+
+// URLHTTPWithEmptyQueryValue returns a *url.URL for "http://example.com/?a=".
+// Query()["a"] is [""].
+func URLHTTPWithEmptyQueryValue() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?a=")
+}
+
+// URLHTTPWithBareQueryKey returns a *url.URL for "http://example.com/?a".
+// Query()["a"] is [""], the same as an empty value.
+func URLHTTPWithBareQueryKey() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?a")
+}
+
+// URLHTTPWithEmptyQuery returns a *url.URL for "http://example.com/?".
+// ForceQuery is true, RawQuery is empty, and Query() is empty.
+func URLHTTPWithEmptyQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?")
+}
+
+// URLHTTPWithSemicolonQuery returns a *url.URL for
+// "http://example.com/?a=1;b=2". Since Go 1.17 semicolons are not separators:
+// url.ParseQuery returns an error and Query() silently drops the pair, so
+// Query() is empty.
+func URLHTTPWithSemicolonQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?a=1;b=2")
+}
+
+// URLHTTPWithPlusQuery returns a *url.URL for "http://example.com/?q=a+b".
+// Query().Get("q") is "a b".
+func URLHTTPWithPlusQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=a+b")
+}
+
+// URLHTTPWithPercent20Query returns a *url.URL for
+// "http://example.com/?q=a%20b". Query().Get("q") is "a b", the same as
+// URLHTTPWithPlusQuery.
+func URLHTTPWithPercent20Query() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=a%20b")
+}
+
+// URLHTTPWithEncodedPlusQuery returns a *url.URL for
+// "http://example.com/?q=a%2Bb". Query().Get("q") is "a+b".
+func URLHTTPWithEncodedPlusQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=a%2Bb")
+}
+
+// URLHTTPWithUnicodeQuery returns a *url.URL for
+// "http://example.com/?q=%C3%BC". Query().Get("q") is "ü".
+func URLHTTPWithUnicodeQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=%C3%BC")
+}
+
+// URLHTTPWithRawUnicodeQuery returns a *url.URL for "http://example.com/?q=ü".
+// url.Parse keeps the raw bytes in RawQuery and Query().Get("q") is "ü".
+func URLHTTPWithRawUnicodeQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=ü")
+}
+
+// URLHTTPWithEncodedReservedQuery returns a *url.URL for
+// "http://example.com/?q=a%26b%3Dc". Query().Get("q") is "a&b=c".
+func URLHTTPWithEncodedReservedQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=a%26b%3Dc")
+}
+
+// URLHTTPWithReservedQuery returns a *url.URL for
+// "http://example.com/?q=a/b?c:d@e". Reserved characters other than "&" and
+// "=" need no escaping, so Query().Get("q") is "a/b?c:d@e".
+func URLHTTPWithReservedQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=a/b?c:d@e")
+}
+
+// URLHTTPWithInvalidEscapeQuery returns a *url.URL for
+// "http://example.com/?q=%zz&ok=1". url.Parse does not validate the query, but
+// url.ParseQuery returns an error for the bad escape and Query() drops that
+// pair, so Query() holds only "ok".
+func URLHTTPWithInvalidEscapeQuery() *url.URL {
+	return MustParse("http://" + ExampleHost + "/?q=%zz&ok=1")
+}
+
+// URLHTTPWithLongQuery returns a *url.URL for "http://example.com/?q=aaa…"
+// whose String() is exactly n bytes long, padding the q value with "a". Pass
+// one of the URLLengthLimit constants, plus or minus one, to probe a limit.
+// It panics if n is shorter than the URL with an empty q value.
+func URLHTTPWithLongQuery(n int) *url.URL {
+	prefix := "http://" + ExampleHost + "/?q="
+	if n < len(prefix) {
+		panic("testurl: URLHTTPWithLongQuery length is shorter than the URL prefix")
+	}
+	return MustParse(prefix + strings.Repeat("a", n-len(prefix)))
+}
+
+// OrderedValues is a query string builder that, unlike url.Values, encodes
+// parameters in the order they were added. The zero value is ready to use.
+type OrderedValues struct {
+	pairs [][2]string
+}
+
+// NewOrderedValues returns an empty OrderedValues.
+func NewOrderedValues() *OrderedValues {
+	return &OrderedValues{}
+}
+
+// Add appends a key/value pair, keeping any earlier pairs with the same key.
+func (v *OrderedValues) Add(key, value string) *OrderedValues {
+	v.pairs = append(v.pairs, [2]string{key, value})
+	return v
+}
+
+// Set replaces every pair with the given key by a single pair at the position
+// of the first one, or appends it if the key is new.
+func (v *OrderedValues) Set(key, value string) *OrderedValues {
+	out := make([][2]string, 0, len(v.pairs))
+	found := false
+	for _, kv := range v.pairs {
+		if kv[0] != key {
+			out = append(out, kv)
+			continue
+		}
+		if !found {
+			out = append(out, [2]string{key, value})
+			found = true
+		}
+	}
+	v.pairs = out
+	if !found {
+		v.pairs = append(v.pairs, [2]string{key, value})
+	}
+	return v
+}
+
+// Encode returns the pairs in "key=value&key=value" form, escaped with
+// url.QueryEscape, in insertion order.
+func (v *OrderedValues) Encode() string {
+	parts := make([]string, 0, len(v.pairs))
+	for _, kv := range v.pairs {
+		parts = append(parts, url.QueryEscape(kv[0])+"="+url.QueryEscape(kv[1]))
+	}
+	return strings.Join(parts, "&")
+}
+
+// Values returns the pairs as a new url.Values. Values for repeated keys keep
+// their order; the order of keys is lost.
+func (v *OrderedValues) Values() url.Values {
+	out := make(url.Values, len(v.pairs))
+	for _, kv := range v.pairs {
+		out.Add(kv[0], kv[1])
+	}
+	return out
+}
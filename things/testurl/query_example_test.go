@@ -0,0 +1,77 @@
+package testurl
+
+import (
+	"fmt"
+)
+
+func ExampleURLHTTPWithRepeatedQuery() {
+	fmt.Println(URLHTTPWithRepeatedQuery().String())
+	// Output: http://example.com/?a=1&a=2
+}
+
+func ExampleURLHTTPWithEmptyQueryValue() {
+	fmt.Println(URLHTTPWithEmptyQueryValue().String())
+	// Output: http://example.com/?a=
+}
+
+func ExampleURLHTTPWithBareQueryKey() {
+	fmt.Println(URLHTTPWithBareQueryKey().String())
+	// Output: http://example.com/?a
+}
+
+func ExampleURLHTTPWithEmptyQuery() {
+	fmt.Println(URLHTTPWithEmptyQuery().String())
+	// Output: http://example.com/?
+}
+
+func ExampleURLHTTPWithSemicolonQuery() {
+	fmt.Println(URLHTTPWithSemicolonQuery().String())
+	// Output: http://example.com/?a=1;b=2
+}
+
+func ExampleURLHTTPWithPlusQuery() {
+	fmt.Println(URLHTTPWithPlusQuery().String())
+	// Output: http://example.com/?q=a+b
+}
+
+func ExampleURLHTTPWithPercent20Query() {
+	fmt.Println(URLHTTPWithPercent20Query().String())
+	// Output: http://example.com/?q=a%20b
+}
+
+func ExampleURLHTTPWithEncodedPlusQuery() {
+	fmt.Println(URLHTTPWithEncodedPlusQuery().String())
+	// Output: http://example.com/?q=a%2Bb
+}
+
+func ExampleURLHTTPWithUnicodeQuery() {
+	fmt.Println(URLHTTPWithUnicodeQuery().String())
+	// Output: http://example.com/?q=%C3%BC
+}
+
+func ExampleURLHTTPWithRawUnicodeQuery() {
+	fmt.Println(URLHTTPWithRawUnicodeQuery().String())
+	// Output: http://example.com/?q=ü
+}
+
+func ExampleURLHTTPWithEncodedReservedQuery() {
+	fmt.Println(URLHTTPWithEncodedReservedQuery().String())
+	// Output: http://example.com/?q=a%26b%3Dc
+}
+
+func ExampleURLHTTPWithReservedQuery() {
+	fmt.Println(URLHTTPWithReservedQuery().String())
+	// Output: http://example.com/?q=a/b?c:d@e
+}
+
+func ExampleURLHTTPWithInvalidEscapeQuery() {
+	fmt.Println(URLHTTPWithInvalidEscapeQuery().String())
+	// Output: http://example.com/?q=%zz&ok=1
+}
+
+// ExampleOrderedValues demonstrates building a query that keeps insertion order.
+func ExampleOrderedValues() {
+	q := NewOrderedValues().Add("sort", "desc").Add("filter", "a").Add("filter", "b")
+	fmt.Println(q.Encode())
+	// Output: sort=desc&filter=a&filter=b
+}
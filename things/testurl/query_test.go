@@ -0,0 +1,173 @@
+package testurl
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// TestQueryURLFunctions verifies each query helper's string form and how Query() interprets it.
+func TestQueryURLFunctions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fn       func() *url.URL
+		expected string
+		query    url.Values
+	}{
+		{
+			"URLHTTPWithRepeatedQuery",
+			URLHTTPWithRepeatedQuery,
+			"http://example.com/?a=1&a=2",
+			url.Values{"a": {"1", "2"}},
+		},
+		{
+			"URLHTTPWithEmptyQueryValue",
+			URLHTTPWithEmptyQueryValue,
+			"http://example.com/?a=",
+			url.Values{"a": {""}},
+		},
+		{
+			"URLHTTPWithBareQueryKey",
+			URLHTTPWithBareQueryKey,
+			"http://example.com/?a",
+			url.Values{"a": {""}},
+		},
+		{
+			"URLHTTPWithEmptyQuery",
+			URLHTTPWithEmptyQuery,
+			"http://example.com/?",
+			url.Values{},
+		},
+		{
+			"URLHTTPWithSemicolonQuery",
+			URLHTTPWithSemicolonQuery,
+			"http://example.com/?a=1;b=2",
+			url.Values{},
+		},
+		{
+			"URLHTTPWithPlusQuery",
+			URLHTTPWithPlusQuery,
+			"http://example.com/?q=a+b",
+			url.Values{"q": {"a b"}},
+		},
+		{
+			"URLHTTPWithPercent20Query",
+			URLHTTPWithPercent20Query,
+			"http://example.com/?q=a%20b",
+			url.Values{"q": {"a b"}},
+		},
+		{
+			"URLHTTPWithEncodedPlusQuery",
+			URLHTTPWithEncodedPlusQuery,
+			"http://example.com/?q=a%2Bb",
+			url.Values{"q": {"a+b"}},
+		},
+		{
+			"URLHTTPWithUnicodeQuery",
+			URLHTTPWithUnicodeQuery,
+			"http://example.com/?q=%C3%BC",
+			url.Values{"q": {"ü"}},
+		},
+		{
+			"URLHTTPWithRawUnicodeQuery",
+			URLHTTPWithRawUnicodeQuery,
+			"http://example.com/?q=ü",
+			url.Values{"q": {"ü"}},
+		},
+		{
+			"URLHTTPWithEncodedReservedQuery",
+			URLHTTPWithEncodedReservedQuery,
+			"http://example.com/?q=a%26b%3Dc",
+			url.Values{"q": {"a&b=c"}},
+		},
+		{
+			"URLHTTPWithReservedQuery",
+			URLHTTPWithReservedQuery,
+			"http://example.com/?q=a/b?c:d@e",
+			url.Values{"q": {"a/b?c:d@e"}},
+		},
+		{
+			"URLHTTPWithInvalidEscapeQuery",
+			URLHTTPWithInvalidEscapeQuery,
+			"http://example.com/?q=%zz&ok=1",
+			url.Values{"ok": {"1"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := tc.fn()
+			if u.String() != tc.expected {
+				t.Errorf("Expected %s to be %q, got %q", tc.name, tc.expected, u.String())
+			}
+			if got := u.Query(); !reflect.DeepEqual(got, tc.query) {
+				t.Errorf("Expected Query() to be %v, got %v", tc.query, got)
+			}
+		})
+	}
+}
+
+func TestQueryParseErrors(t *testing.T) {
+	t.Parallel()
+	for name, fn := range map[string]func() *url.URL{
+		"URLHTTPWithSemicolonQuery":     URLHTTPWithSemicolonQuery,
+		"URLHTTPWithInvalidEscapeQuery": URLHTTPWithInvalidEscapeQuery,
+	} {
+		if _, err := url.ParseQuery(fn().RawQuery); err == nil {
+			t.Errorf("Expected url.ParseQuery to fail for %s", name)
+		}
+	}
+}
+
+func TestURLHTTPWithLongQuery(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{
+		len("http://example.com/?q="),
+		URLLengthLimitBrowser - 1,
+		URLLengthLimitBrowser,
+		URLLengthLimitApache + 1,
+		URLLengthLimitNginx,
+	} {
+		if got := len(URLHTTPWithLongQuery(n).String()); got != n {
+			t.Errorf("Expected length %d, got %d", n, got)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a length shorter than the prefix")
+		}
+	}()
+	URLHTTPWithLongQuery(5)
+}
+
+func TestOrderedValues(t *testing.T) {
+	t.Parallel()
+
+	var zero OrderedValues
+	if zero.Encode() != "" {
+		t.Errorf("Expected empty encoding for zero value, got %q", zero.Encode())
+	}
+
+	v := NewOrderedValues().Add("z", "1").Add("a", "x y").Add("z", "2")
+	if got := v.Encode(); got != "z=1&a=x+y&z=2" {
+		t.Errorf("Expected insertion order, got %q", got)
+	}
+	want := url.Values{"z": {"1", "2"}, "a": {"x y"}}
+	if got := v.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	v.Set("z", "3").Set("b", "4")
+	if got := v.Encode(); got != "z=3&a=x+y&b=4" {
+		t.Errorf("Expected Set to replace in place and append new keys, got %q", got)
+	}
+
+	before := *v
+	v.Set("z", "5")
+	if got := before.Encode(); got != "z=3&a=x+y&b=4" {
+		t.Errorf("Expected Set to leave earlier copies alone, got %q", got)
+	}
+}
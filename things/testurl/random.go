@@ -0,0 +1,180 @@
+package testurl
+
+import (
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Constraints shapes the URLs produced by Random. The zero value enables
+// every feature with sensible limits.
+type Constraints struct {
+	// Schemes to choose from. Defaults to http, https, ws, wss, and ftp.
+	Schemes []string
+
+	// MaxPathSegments caps the number of path segments. Defaults to 4.
+	MaxPathSegments int
+
+	// MaxQueryParams caps the number of query parameters. Defaults to 4.
+	MaxQueryParams int
+
+	// ASCIIHostsOnly disables internationalized host names.
+	ASCIIHostsOnly bool
+
+	// NoIPLiterals disables IPv4 and IPv6 literal hosts.
+	NoIPLiterals bool
+
+	// NoPort disables explicit ports.
+	NoPort bool
+
+	// NoUserinfo disables usernames and passwords.
+	NoUserinfo bool
+
+	// NoFragment disables fragments.
+	NoFragment bool
+}
+
+var (
+	defaultRandomSchemes = []string{"http", "https", "ws", "wss", "ftp"}
+
+	// randomTLDs are reserved by RFC 2606 and RFC 6761, so generated names
+	// never resolve.
+	randomTLDs = []string{"example", "test", "invalid"}
+
+	// randomIDNRunes are letters that force a host into its Unicode form.
+	randomIDNRunes = []rune("äöüßéñçøåλжд中文日本")
+
+	hostChars    = "abcdefghijklmnopqrstuvwxyz0123456789"
+	segmentChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789" +
+		"-._~:@!$&'()*+,;= %"
+	queryChars = "abcdefghijklmnopqrstuvwxyz0123456789-._~!$'()*,;:@/? &=+#%"
+)
+
+// Random returns a new *url.URL generated from src within the limits set by
+// c. The same source state always yields the same URL, and every URL
+// survives a round trip through url.Parse(u.String()) unchanged.
+//
+// Hosts are random labels under a reserved top-level domain, internationalized
+// names, or IP literals, so generated URLs are safe to print but are meant
+// for parsers, not for network calls.
+func Random(src rand.Source, c Constraints) *url.URL {
+	r := rand.New(src)
+
+	schemes := c.Schemes
+	if len(schemes) == 0 {
+		schemes = defaultRandomSchemes
+	}
+	maxSegments := c.MaxPathSegments
+	if maxSegments <= 0 {
+		maxSegments = 4
+	}
+	maxParams := c.MaxQueryParams
+	if maxParams <= 0 {
+		maxParams = 4
+	}
+
+	u := &url.URL{
+		Scheme: schemes[r.IntN(len(schemes))],
+		Host:   randomHost(r, c),
+	}
+
+	for range r.IntN(maxSegments + 1) {
+		u.Path += "/" + randomString(r, segmentChars, 0, 8)
+	}
+
+	params := make([]string, 0, maxParams)
+	var keys []string
+	for range r.IntN(maxParams + 1) {
+		key := randomString(r, queryChars, 1, 6)
+		// Reuse an earlier key now and then to produce repeated keys.
+		if len(keys) > 0 && r.IntN(3) == 0 {
+			key = keys[r.IntN(len(keys))]
+		}
+		keys = append(keys, key)
+		value := randomString(r, queryChars, 0, 8)
+		params = append(params, url.QueryEscape(key)+"="+url.QueryEscape(value))
+	}
+	u.RawQuery = strings.Join(params, "&")
+
+	if !c.NoUserinfo && r.IntN(4) == 0 {
+		user := randomString(r, segmentChars, 1, 6)
+		if r.IntN(2) == 0 {
+			u.User = url.User(user)
+		} else {
+			u.User = url.UserPassword(user, randomString(r, segmentChars, 0, 8))
+		}
+	}
+
+	if !c.NoFragment && r.IntN(3) == 0 {
+		u.Fragment = randomString(r, queryChars, 0, 8)
+	}
+
+	return u
+}
+
+// SeedCorpus adds n URLs generated by Random to the fuzz corpus of f, each as
+// a single string argument. Use it with fuzz targets of the form
+// func(t *testing.T, raw string).
+func SeedCorpus(f *testing.F, src rand.Source, c Constraints, n int) {
+	f.Helper()
+
+	for range n {
+		f.Add(Random(src, c).String())
+	}
+}
+
+// randomHost returns a host name or IP literal, with a port unless disabled.
+func randomHost(r *rand.Rand, c Constraints) string {
+	var host string
+	kind := r.IntN(4)
+	switch {
+	case kind == 0 && !c.NoIPLiterals:
+		host = netip.AddrFrom4([4]byte{
+			byte(r.UintN(256)), byte(r.UintN(256)), byte(r.UintN(256)), byte(r.UintN(256)),
+		}).String()
+	case kind == 1 && !c.NoIPLiterals:
+		var b [16]byte
+		for i := range b {
+			b[i] = byte(r.UintN(256))
+		}
+		host = netip.AddrFrom16(b).String()
+	default:
+		labels := make([]string, 0, 3)
+		for range 1 + r.IntN(2) {
+			labels = append(labels, randomLabel(r, kind == 2 && !c.ASCIIHostsOnly))
+		}
+		host = strings.Join(append(labels, randomTLDs[r.IntN(len(randomTLDs))]), ".")
+	}
+
+	if !c.NoPort && r.IntN(2) == 0 {
+		return net.JoinHostPort(host, strconv.Itoa(1+r.IntN(65535)))
+	}
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
+// randomLabel returns a DNS label, optionally containing non-ASCII letters.
+func randomLabel(r *rand.Rand, idn bool) string {
+	label := []rune(randomString(r, hostChars, 1, 10))
+	if idn {
+		for range 1 + r.IntN(2) {
+			label[r.IntN(len(label))] = randomIDNRunes[r.IntN(len(randomIDNRunes))]
+		}
+	}
+	return string(label)
+}
+
+// randomString returns between minLen and maxLen bytes drawn from chars.
+func randomString(r *rand.Rand, chars string, minLen, maxLen int) string {
+	b := make([]byte, minLen+r.IntN(maxLen-minLen+1))
+	for i := range b {
+		b[i] = chars[r.IntN(len(chars))]
+	}
+	return string(b)
+}
@@ -0,0 +1,16 @@
+package testurl
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/url"
+)
+
+// ExampleRandom demonstrates that generated URLs survive a parse round trip.
+func ExampleRandom() {
+	u := Random(rand.NewPCG(1, 1), Constraints{})
+
+	p, err := url.Parse(u.String())
+	fmt.Println(err == nil && p.String() == u.String())
+	// Output: true
+}
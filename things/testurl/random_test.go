@@ -0,0 +1,133 @@
+package testurl
+
+import (
+	"math/rand/v2"
+	"net/url"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRandomRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for seed := range uint64(2000) {
+		u := Random(rand.NewPCG(seed, 0), Constraints{})
+		s := u.String()
+
+		p, err := url.Parse(s)
+		if err != nil {
+			t.Fatalf("seed %d: url.Parse(%q) failed: %v", seed, s, err)
+		}
+		if p.String() != s {
+			t.Fatalf("seed %d: expected round trip to %q, got %q", seed, s, p.String())
+		}
+		if p.Scheme != u.Scheme || p.Host != u.Host || p.Path != u.Path ||
+			p.RawQuery != u.RawQuery || p.Fragment != u.Fragment ||
+			p.User.String() != u.User.String() {
+			t.Fatalf("seed %d: components changed in round trip:\n  %#v\n  %#v", seed, u, p)
+		}
+	}
+}
+
+func TestRandomDeterministic(t *testing.T) {
+	t.Parallel()
+
+	a := Random(rand.NewPCG(42, 7), Constraints{})
+	b := Random(rand.NewPCG(42, 7), Constraints{})
+	if a.String() != b.String() {
+		t.Errorf("Expected identical URLs for identical seeds, got %q and %q", a, b)
+	}
+	if a == b {
+		t.Error("Expected a new *url.URL on each call")
+	}
+}
+
+func TestRandomCoverage(t *testing.T) {
+	t.Parallel()
+
+	var idn, ipv4, ipv6, port, user, fragment, repeated bool
+	src := rand.NewPCG(1, 2)
+	for range 500 {
+		u := Random(src, Constraints{})
+		host := u.Hostname()
+		switch {
+		case strings.Contains(host, ":"):
+			ipv6 = true
+		case !utf8.ValidString(host) || strings.ContainsFunc(host, func(r rune) bool { return r > 127 }):
+			idn = true
+		case strings.Count(host, ".") == 3 && !strings.ContainsAny(host, "abcdefghijklmnopqrstuvwxyz"):
+			ipv4 = true
+		}
+		port = port || u.Port() != ""
+		user = user || u.User != nil
+		fragment = fragment || u.Fragment != ""
+		for _, v := range u.Query() {
+			repeated = repeated || len(v) > 1
+		}
+	}
+
+	for name, seen := range map[string]bool{
+		"IDN host":       idn,
+		"IPv4 host":      ipv4,
+		"IPv6 host":      ipv6,
+		"port":           port,
+		"userinfo":       user,
+		"fragment":       fragment,
+		"repeated query": repeated,
+	} {
+		if !seen {
+			t.Errorf("Expected at least one URL with a %s", name)
+		}
+	}
+}
+
+func TestRandomConstraints(t *testing.T) {
+	t.Parallel()
+
+	c := Constraints{
+		Schemes:         []string{"wss"},
+		MaxPathSegments: 1,
+		MaxQueryParams:  1,
+		ASCIIHostsOnly:  true,
+		NoIPLiterals:    true,
+		NoPort:          true,
+		NoUserinfo:      true,
+		NoFragment:      true,
+	}
+	src := rand.NewPCG(3, 4)
+	for range 500 {
+		u := Random(src, c)
+		if u.Scheme != "wss" {
+			t.Fatalf("Expected scheme wss, got %q", u.Scheme)
+		}
+		if u.Port() != "" || u.User != nil || u.Fragment != "" {
+			t.Fatalf("Expected no port, userinfo, or fragment, got %q", u)
+		}
+		if strings.Count(u.Path, "/") > 1 || len(u.Query()) > 1 {
+			t.Fatalf("Expected at most one segment and parameter, got %q", u)
+		}
+		host := u.Hostname()
+		if strings.ContainsFunc(host, func(r rune) bool { return r > 127 }) {
+			t.Fatalf("Expected ASCII host, got %q", host)
+		}
+		tld := host[strings.LastIndex(host, ".")+1:]
+		if tld != "example" && tld != "test" && tld != "invalid" {
+			t.Fatalf("Expected reserved TLD, got %q", host)
+		}
+	}
+}
+
+func FuzzSeedCorpus(f *testing.F) {
+	SeedCorpus(f, rand.NewPCG(5, 6), Constraints{}, 50)
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return
+		}
+		if _, err := url.Parse(u.String()); err != nil {
+			t.Errorf("url.Parse(%q) failed after a successful parse of %q: %v", u, raw, err)
+		}
+	})
+}
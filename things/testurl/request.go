@@ -0,0 +1,245 @@
+package testurl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	// MalformedHostHeader is the Host value set by RequestMalformedHost. The
+	// space makes it invalid, so net/http servers reject it with 400.
+	MalformedHostHeader = "bad host:port"
+
+	// OversizedHeaderName is the header RequestOversizedHeaders fills.
+	OversizedHeaderName = "X-Oversized"
+
+	// PartialBody is what the body of RequestBodyError yields before failing.
+	PartialBody = "partial"
+)
+
+// ErrBodyRead is returned by the body of RequestBodyError once PartialBody
+// has been read.
+var ErrBodyRead = errors.New("testurl: body read failed")
+
+// RequestOption configures a request built by Request.
+type RequestOption func(*requestConfig)
+
+// requestConfig collects the options before the request is built.
+type requestConfig struct {
+	ctx         context.Context
+	header      http.Header
+	body        io.Reader
+	contentType string
+	cookies     []*http.Cookie
+	basicAuth   *url.Userinfo
+	remoteAddr  string
+}
+
+// MultipartFile is a file part for WithMultipart.
+type MultipartFile struct {
+	// Field is the form field name.
+	Field string
+
+	// Name is the file name sent in the Content-Disposition header.
+	Name string
+
+	// Content is the file body.
+	Content []byte
+}
+
+// WithHeader adds a header value, keeping any earlier values for key. Setting
+// Content-Type here overrides the type chosen by a body option.
+func WithHeader(key, value string) RequestOption {
+	return func(c *requestConfig) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithBody sets a raw body and its Content-Type. An empty contentType leaves
+// the header unset. The reader is shared by every request the option builds,
+// so create a new option for each request.
+func WithBody(body io.Reader, contentType string) RequestOption {
+	return func(c *requestConfig) {
+		c.body = body
+		c.contentType = contentType
+	}
+}
+
+// WithJSON sets the body to v encoded with encoding/json and the
+// Content-Type to "application/json". It panics if v cannot be encoded.
+func WithJSON(v any) RequestOption {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("testurl: failed to encode JSON body: %v", err))
+	}
+	return withBytes(b, "application/json")
+}
+
+// WithForm sets the body to the URL-encoded values and the Content-Type to
+// "application/x-www-form-urlencoded".
+func WithForm(values url.Values) RequestOption {
+	return withBytes([]byte(values.Encode()), "application/x-www-form-urlencoded")
+}
+
+// WithMultipart sets a "multipart/form-data" body holding the fields, in key
+// order, followed by the files.
+func WithMultipart(fields url.Values, files ...MultipartFile) RequestOption {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range fields[k] {
+			_ = w.WriteField(k, v)
+		}
+	}
+	for _, f := range files {
+		part, _ := w.CreateFormFile(f.Field, f.Name)
+		_, _ = part.Write(f.Content)
+	}
+	_ = w.Close()
+
+	return withBytes(buf.Bytes(), w.FormDataContentType())
+}
+
+// withBytes sets a body that is read afresh by every request the option is
+// used for.
+func withBytes(b []byte, contentType string) RequestOption {
+	return func(c *requestConfig) {
+		c.body = bytes.NewReader(b)
+		c.contentType = contentType
+	}
+}
+
+// WithCookie adds a Cookie header for c.
+func WithCookie(c *http.Cookie) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.cookies = append(cfg.cookies, c)
+	}
+}
+
+// WithBasicAuth sets an Authorization header for HTTP basic authentication.
+func WithBasicAuth(username, password string) RequestOption {
+	return func(c *requestConfig) {
+		c.basicAuth = url.UserPassword(username, password)
+	}
+}
+
+// WithContext sets the request context, for example a fakectx context that
+// is already cancelled.
+func WithContext(ctx context.Context) RequestOption {
+	return func(c *requestConfig) {
+		c.ctx = ctx
+	}
+}
+
+// WithRemoteAddr sets RemoteAddr, which defaults to "192.0.2.1:1234".
+func WithRemoteAddr(addr string) RequestOption {
+	return func(c *requestConfig) {
+		c.remoteAddr = addr
+	}
+}
+
+// Request returns a new incoming server request for u, as passed to an
+// http.Handler, shaped by opts. It builds on httptest.NewRequest: the Host is
+// taken from u, RemoteAddr defaults to "192.0.2.1:1234", and https URLs get
+// a non-nil TLS field. Fragments are never sent, so the fragment of u is
+// dropped. It panics if u is nil.
+func Request(method string, u *url.URL, opts ...RequestOption) *http.Request {
+	if u == nil {
+		panic("testurl: Request called with a nil URL; use RequestNilURL")
+	}
+
+	c := &requestConfig{ctx: context.Background(), header: make(http.Header)}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	target := *u
+	target.Fragment = ""
+	target.RawFragment = ""
+	r := httptest.NewRequestWithContext(c.ctx, method, target.String(), c.body)
+
+	if c.contentType != "" {
+		r.Header.Set("Content-Type", c.contentType)
+	}
+	for k, v := range c.header {
+		r.Header[k] = append([]string(nil), v...)
+	}
+	for _, cookie := range c.cookies {
+		r.AddCookie(cookie)
+	}
+	if c.basicAuth != nil {
+		password, _ := c.basicAuth.Password()
+		r.SetBasicAuth(c.basicAuth.Username(), password)
+	}
+	if c.remoteAddr != "" {
+		r.RemoteAddr = c.remoteAddr
+	}
+	return r
+}
+
+// RequestNilURL returns a request built like Request for URLHTTP, with its
+// URL field then set to nil. Handlers that dereference r.URL panic on it.
+func RequestNilURL(method string, opts ...RequestOption) *http.Request {
+	r := Request(method, URLHTTP(), opts...)
+	r.URL = nil
+	return r
+}
+
+// RequestMalformedHost returns a request for u whose Host is
+// MalformedHostHeader, a value a real server would never let through.
+func RequestMalformedHost(method string, u *url.URL, opts ...RequestOption) *http.Request {
+	r := Request(method, u, opts...)
+	r.Host = MalformedHostHeader
+	return r
+}
+
+// RequestOversizedHeaders returns a request for u carrying an
+// OversizedHeaderName header one byte longer than
+// http.DefaultMaxHeaderBytes, so the headers alone exceed the default
+// server limit.
+func RequestOversizedHeaders(method string, u *url.URL, opts ...RequestOption) *http.Request {
+	r := Request(method, u, opts...)
+	r.Header.Set(OversizedHeaderName, strings.Repeat("a", http.DefaultMaxHeaderBytes+1))
+	return r
+}
+
+// RequestBodyError returns a request for u whose body yields PartialBody and
+// then fails with ErrBodyRead. It replaces any body set by opts, and
+// ContentLength is -1 since the length is unknown.
+func RequestBodyError(method string, u *url.URL, opts ...RequestOption) *http.Request {
+	opts = append(opts[:len(opts):len(opts)], func(c *requestConfig) {
+		c.body = &failingReader{data: []byte(PartialBody)}
+		c.contentType = "application/octet-stream"
+	})
+	return Request(method, u, opts...)
+}
+
+// failingReader yields data and then ErrBodyRead on every later read.
+type failingReader struct {
+	data []byte
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if len(f.data) == 0 {
+		return 0, ErrBodyRead
+	}
+	n := copy(p, f.data)
+	f.data = f.data[n:]
+	return n, nil
+}
@@ -0,0 +1,28 @@
+package testurl
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+func ExampleRequest() {
+	r := Request(http.MethodPost, URLHTTPWithPath(),
+		WithJSON(map[string]string{"name": "gopher"}),
+		WithBasicAuth(TestUsername, TestPassword),
+	)
+	body, _ := io.ReadAll(r.Body)
+	user, _, _ := r.BasicAuth()
+	fmt.Println(r.Method, r.URL.Path, r.Header.Get("Content-Type"), user)
+	fmt.Println(string(body))
+	// Output:
+	// POST /path/to/resource application/json user
+	// {"name":"gopher"}
+}
+
+func ExampleRequestBodyError() {
+	r := RequestBodyError(http.MethodPost, URLHTTP())
+	body, err := io.ReadAll(r.Body)
+	fmt.Printf("%q %v\n", body, err)
+	// Output: "partial" testurl: body read failed
+}
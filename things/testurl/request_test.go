@@ -0,0 +1,238 @@
+package testurl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+		r := Request(http.MethodGet, URLHTTPWithPathAndQuery())
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected method GET, got %q", r.Method)
+		}
+		if r.Host != ExampleHost || r.URL.Path != "/path/to/resource" ||
+			r.URL.Query().Get("query") != "1" {
+			t.Errorf("Unexpected host %q and URL %q", r.Host, r.URL)
+		}
+		if r.RemoteAddr != "192.0.2.1:1234" {
+			t.Errorf("Expected default RemoteAddr, got %q", r.RemoteAddr)
+		}
+		if r.TLS != nil {
+			t.Error("Expected no TLS state for http URL")
+		}
+		if r.Context() != context.Background() {
+			t.Error("Expected background context")
+		}
+	})
+
+	t.Run("HTTPS and fragment", func(t *testing.T) {
+		t.Parallel()
+		r := Request(http.MethodGet, URLHTTPSWithFragment())
+		if r.TLS == nil {
+			t.Error("Expected TLS state for https URL")
+		}
+		if r.URL.Fragment != "" || strings.Contains(r.URL.Path, "#") {
+			t.Errorf("Expected fragment to be dropped, got %q", r.URL)
+		}
+	})
+
+	t.Run("Headers", func(t *testing.T) {
+		t.Parallel()
+		r := Request(http.MethodGet, URLHTTP(),
+			WithHeader("X-Test", "a"),
+			WithHeader("X-Test", "b"),
+			WithHeader("Accept", "text/plain"),
+		)
+		if got := r.Header.Values("X-Test"); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+			t.Errorf("Expected X-Test [a b], got %q", got)
+		}
+		if r.Header.Get("Accept") != "text/plain" {
+			t.Errorf("Expected Accept header, got %q", r.Header.Get("Accept"))
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+		opt := WithJSON(map[string]int{"a": 1})
+		for range 2 {
+			r := Request(http.MethodPost, URLHTTP(), opt)
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"a":1}` {
+				t.Errorf("Expected JSON body, got %q", body)
+			}
+			if r.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("Expected JSON content type, got %q", r.Header.Get("Content-Type"))
+			}
+			if r.ContentLength != int64(len(body)) {
+				t.Errorf("Expected ContentLength %d, got %d", len(body), r.ContentLength)
+			}
+		}
+	})
+
+	t.Run("JSON panics", func(t *testing.T) {
+		t.Parallel()
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for unencodable value")
+			}
+		}()
+		WithJSON(make(chan int))
+	})
+
+	t.Run("Content-Type override", func(t *testing.T) {
+		t.Parallel()
+		r := Request(http.MethodPost, URLHTTP(),
+			WithJSON(1),
+			WithHeader("Content-Type", "application/vnd.api+json"),
+		)
+		if got := r.Header.Values("Content-Type"); len(got) != 1 ||
+			got[0] != "application/vnd.api+json" {
+			t.Errorf("Expected overridden content type, got %q", got)
+		}
+	})
+
+	t.Run("Form", func(t *testing.T) {
+		t.Parallel()
+		r := Request(
+			http.MethodPost,
+			URLHTTP(),
+			WithForm(url.Values{"name": {"gopher"}, "tag": {"a", "b"}}),
+		)
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if r.PostForm.Get("name") != "gopher" || len(r.PostForm["tag"]) != 2 {
+			t.Errorf("Unexpected form %v", r.PostForm)
+		}
+	})
+
+	t.Run("Multipart", func(t *testing.T) {
+		t.Parallel()
+		r := Request(http.MethodPost, URLHTTP(), WithMultipart(
+			url.Values{"name": {"gopher"}},
+			MultipartFile{Field: "upload", Name: "hello.txt", Content: []byte("hello")},
+		))
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if r.FormValue("name") != "gopher" {
+			t.Errorf("Expected name field, got %q", r.FormValue("name"))
+		}
+		f, h, err := r.FormFile("upload")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer f.Close()
+		content, _ := io.ReadAll(f)
+		if h.Filename != "hello.txt" || string(content) != "hello" {
+			t.Errorf("Unexpected file %q with %q", h.Filename, content)
+		}
+	})
+
+	t.Run("Cookies and auth", func(t *testing.T) {
+		t.Parallel()
+		r := Request(http.MethodGet, URLHTTP(),
+			WithCookie(&http.Cookie{Name: "session", Value: "abc"}),
+			WithCookie(&http.Cookie{Name: "theme", Value: "dark"}),
+			WithBasicAuth(TestUsername, TestPassword),
+		)
+		if c, err := r.Cookie("session"); err != nil || c.Value != "abc" {
+			t.Errorf("Expected session cookie, got %v, %v", c, err)
+		}
+		if len(r.Cookies()) != 2 {
+			t.Errorf("Expected 2 cookies, got %d", len(r.Cookies()))
+		}
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != TestUsername || pass != TestPassword {
+			t.Errorf("Unexpected basic auth %q %q %t", user, pass, ok)
+		}
+	})
+
+	t.Run("Context and RemoteAddr", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		r := Request(
+			http.MethodGet,
+			URLHTTP(),
+			WithContext(ctx),
+			WithRemoteAddr("203.0.113.9:4000"),
+		)
+		if !errors.Is(r.Context().Err(), context.Canceled) {
+			t.Errorf("Expected cancelled context, got %v", r.Context().Err())
+		}
+		if r.RemoteAddr != "203.0.113.9:4000" {
+			t.Errorf("Expected RemoteAddr to be set, got %q", r.RemoteAddr)
+		}
+	})
+
+	t.Run("Nil URL panics", func(t *testing.T) {
+		t.Parallel()
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for nil URL")
+			}
+		}()
+		Request(http.MethodGet, nil)
+	})
+}
+
+func TestBrokenRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil URL", func(t *testing.T) {
+		t.Parallel()
+		r := RequestNilURL(http.MethodGet, WithHeader("X-Test", "1"))
+		if r.URL != nil {
+			t.Errorf("Expected nil URL, got %q", r.URL)
+		}
+		if r.Header.Get("X-Test") != "1" {
+			t.Error("Expected options to apply")
+		}
+	})
+
+	t.Run("Malformed Host", func(t *testing.T) {
+		t.Parallel()
+		r := RequestMalformedHost(http.MethodGet, URLHTTP())
+		if r.Host != MalformedHostHeader {
+			t.Errorf("Expected Host %q, got %q", MalformedHostHeader, r.Host)
+		}
+	})
+
+	t.Run("Oversized headers", func(t *testing.T) {
+		t.Parallel()
+		r := RequestOversizedHeaders(http.MethodGet, URLHTTP())
+		if n := len(r.Header.Get(OversizedHeaderName)); n <= http.DefaultMaxHeaderBytes {
+			t.Errorf("Expected header longer than %d bytes, got %d", http.DefaultMaxHeaderBytes, n)
+		}
+	})
+
+	t.Run("Body error", func(t *testing.T) {
+		t.Parallel()
+		opts := make([]RequestOption, 1, 2)
+		opts[0] = WithJSON(1)
+		r := RequestBodyError(http.MethodPost, URLHTTP(), opts...)
+		if r.ContentLength != -1 {
+			t.Errorf("Expected unknown ContentLength, got %d", r.ContentLength)
+		}
+		body, err := io.ReadAll(r.Body)
+		if !errors.Is(err, ErrBodyRead) {
+			t.Errorf("Expected ErrBodyRead, got %v", err)
+		}
+		if string(body) != PartialBody {
+			t.Errorf("Expected partial body %q, got %q", PartialBody, body)
+		}
+		if opts[:2][1] != nil {
+			t.Error("Expected caller's option slice to be left alone")
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package testurl
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// Host names under the top-level domains reserved by RFC 2606 and RFC 6761,
+// and addresses from the ranges reserved for documentation by RFC 5737 and
+// RFC 3849. None of them resolve to real infrastructure.
+const (
+	BadHost           = "bad-url.invalid"
+	TestTLDHost       = "service.test"
+	InvalidTLDHost    = "service.invalid"
+	ExampleTLDHost    = "service.example"
+	LocalhostTLDHost  = "service.localhost"
+	DocumentationIPv4 = "192.0.2.1"
+	DocumentationIPv6 = IPv6Host
+)
+
+// reservedTLDs are the top-level domains reserved by RFC 2606 and RFC 6761.
+var reservedTLDs = []string{"test", "invalid", "example", "localhost"}
+
+// reservedDomains are the second-level domains reserved by RFC 2606. They
+// resolve, but only to servers operated by IANA for documentation.
+var reservedDomains = []string{"example.com", "example.net", "example.org"}
+
+// reservedPrefixes are the documentation and loopback address ranges.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("::1/128"),
+}
+
+// URLHTTPTestTLD returns a *url.URL for "http://service.test/".
+func URLHTTPTestTLD() *url.URL {
+	return MustParse("http://" + TestTLDHost + "/")
+}
+
+// URLHTTPInvalidTLD returns a *url.URL for "http://service.invalid/". RFC 6761
+// requires resolvers to answer NXDOMAIN for every .invalid name.
+func URLHTTPInvalidTLD() *url.URL {
+	return MustParse("http://" + InvalidTLDHost + "/")
+}
+
+// URLHTTPExampleTLD returns a *url.URL for "http://service.example/".
+func URLHTTPExampleTLD() *url.URL {
+	return MustParse("http://" + ExampleTLDHost + "/")
+}
+
+// URLHTTPLocalhostTLD returns a *url.URL for "http://service.localhost/". RFC
+// 6761 lets resolvers answer .localhost names with the loopback address.
+func URLHTTPLocalhostTLD() *url.URL {
+	return MustParse("http://" + LocalhostTLDHost + "/")
+}
+
+// URLHTTPDocumentationIPv4 returns a *url.URL for "http://192.0.2.1/", an
+// address from TEST-NET-1. Connections to it should time out rather than
+// reach a real host. URLHTTPIPv6 is its IPv6 counterpart.
+func URLHTTPDocumentationIPv4() *url.URL {
+	return MustParse("http://" + DocumentationIPv4 + "/")
+}
+
+// IsReservedHost reports whether host, with or without a port, is set aside
+// for testing or documentation: names under .test, .invalid, .example, and
+// .localhost, the example.com, example.net, and example.org domains,
+// "localhost" itself, and addresses in the documentation ranges
+// (192.0.2.0/24, 198.51.100.0/24, 203.0.113.0/24, 2001:db8::/32) or the
+// loopback ranges. Matching ignores case and a trailing dot.
+//
+// Reserved does not mean unreachable. The example.com, example.net, and
+// example.org domains resolve to real servers run by IANA, so a request to
+// them leaves the machine. Only names under the reserved top-level domains and
+// addresses in the loopback and documentation ranges never reach a real host.
+func IsReservedHost(host string) bool {
+	if _, err := netip.ParseAddr(host); err != nil {
+		host = (&url.URL{Host: host}).Hostname()
+	}
+	if host == "" {
+		return false
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addr = addr.WithZone("").Unmap()
+		for _, p := range reservedPrefixes {
+			if p.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == LocalhostHost {
+		return true
+	}
+	for _, tld := range reservedTLDs {
+		if host == tld || strings.HasSuffix(host, "."+tld) {
+			return true
+		}
+	}
+	for _, domain := range reservedDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReserved reports whether u has a host and IsReservedHost accepts it.
+// URLs without a host, such as mailto: or file: URLs, are not reserved.
+func IsReserved(u *url.URL) bool {
+	return u != nil && IsReservedHost(u.Host)
+}
+
+// RequireReserved fails the test unless IsReserved accepts every URL in urls.
+// Use it to keep fixtures on names and addresses nobody else owns. It does
+// not keep them off the network, since example.com and its siblings resolve;
+// see IsReservedHost.
+func RequireReserved(t testing.TB, urls ...*url.URL) {
+	t.Helper()
+	if msg := reservedFailure(urls); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// reservedFailure returns the message RequireReserved fails with for the
+// first URL IsReserved rejects, or "" if it accepts them all.
+func reservedFailure(urls []*url.URL) string {
+	for _, u := range urls {
+		switch {
+		case IsReserved(u):
+		case u == nil:
+			return "testurl: nil URL does not use a reserved host name or documentation address"
+		default:
+			return fmt.Sprintf(
+				"testurl: %s does not use a reserved host name or documentation address",
+				u.Redacted(),
+			)
+		}
+	}
+	return ""
+}
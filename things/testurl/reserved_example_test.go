@@ -0,0 +1,38 @@
+package testurl
+
+import (
+	"fmt"
+)
+
+func ExampleURLHTTPTestTLD() {
+	fmt.Println(URLHTTPTestTLD().String())
+	// Output: http://service.test/
+}
+
+func ExampleURLHTTPInvalidTLD() {
+	fmt.Println(URLHTTPInvalidTLD().String())
+	// Output: http://service.invalid/
+}
+
+func ExampleURLHTTPExampleTLD() {
+	fmt.Println(URLHTTPExampleTLD().String())
+	// Output: http://service.example/
+}
+
+func ExampleURLHTTPLocalhostTLD() {
+	fmt.Println(URLHTTPLocalhostTLD().String())
+	// Output: http://service.localhost/
+}
+
+func ExampleURLHTTPDocumentationIPv4() {
+	fmt.Println(URLHTTPDocumentationIPv4().String())
+	// Output: http://192.0.2.1/
+}
+
+func ExampleIsReserved() {
+	fmt.Println(IsReserved(URLHTTPBad()))
+	fmt.Println(IsReserved(MustParse("https://golang.org/")))
+	// Output:
+	// true
+	// false
+}
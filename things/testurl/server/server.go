@@ -0,0 +1,213 @@
+/*
+Package server starts loopback httptest servers with canned behaviors and
+hands back their address as a *url.URL, ready to pair with testurl values.
+
+	github.com/madflojo/testlazy/things/testurl/server
+
+Every fixture listens on 127.0.0.1, needs no outside network, and shuts its
+server down through t.Cleanup, so a test only has to point a client at the
+returned URL.
+
+Example usage
+
+	func TestClientGivesUp(t *testing.T) {
+	    u := server.Hang(t)
+	    client := &http.Client{Timeout: 50 * time.Millisecond}
+	    if _, err := client.Get(u.String()); err == nil {
+	        t.Fatal("expected a timeout")
+	    }
+	}
+
+Fixtures that break the connection (Reset and TruncatedBody) hijack it from
+net/http, so they only work with HTTP/1.x clients.
+*/
+package server
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/madflojo/testlazy/things/testurl"
+)
+
+const (
+	// PartialBody is what the TruncatedBody fixture sends before closing the
+	// connection.
+	PartialBody = "partial"
+
+	// PartialBodyLength is the Content-Length the TruncatedBody fixture
+	// promises, longer than PartialBody.
+	PartialBodyLength = 64
+
+	// LoopFirstPath and LoopSecondPath are the paths the RedirectLoop fixture
+	// bounces between.
+	LoopFirstPath  = "/loop/a"
+	LoopSecondPath = "/loop/b"
+)
+
+// OK starts a server that answers every request with 200 OK and the body
+// "OK".
+func OK(t testing.TB) *url.URL {
+	t.Helper()
+	return Status(t, http.StatusOK)
+}
+
+// Status starts a server that answers every request with code and its
+// http.StatusText as the body.
+func Status(t testing.TB, code int) *url.URL {
+	t.Helper()
+	return start(t, func(w http.ResponseWriter, _ *http.Request, _ <-chan struct{}) {
+		w.WriteHeader(code)
+		fmt.Fprint(w, http.StatusText(code))
+	})
+}
+
+// Slow starts a server that waits for delay before answering 200 OK. If the
+// client gives up first, the handler returns without a response, and if the
+// test ends first, the connection is aborted.
+func Slow(t testing.TB, delay time.Duration) *url.URL {
+	t.Helper()
+	return start(t, func(w http.ResponseWriter, r *http.Request, stop <-chan struct{}) {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			fmt.Fprint(w, http.StatusText(http.StatusOK))
+		case <-r.Context().Done():
+		case <-stop:
+			panic(http.ErrAbortHandler)
+		}
+	})
+}
+
+// Hang starts a server that reads the request and then never answers. The
+// handler returns once the client gives up, and aborts the connection when
+// the test ends, so clients need a timeout or a cancellable context to get
+// past it.
+func Hang(t testing.TB) *url.URL {
+	t.Helper()
+	return start(t, func(_ http.ResponseWriter, r *http.Request, stop <-chan struct{}) {
+		select {
+		case <-r.Context().Done():
+		case <-stop:
+			panic(http.ErrAbortHandler)
+		}
+	})
+}
+
+// Redirect starts a server that answers every request with code, which
+// should be a 3xx status, and a Location header pointing at target.
+func Redirect(t testing.TB, code int, target *url.URL) *url.URL {
+	t.Helper()
+	return start(t, func(w http.ResponseWriter, _ *http.Request, _ <-chan struct{}) {
+		w.Header().Set("Location", target.String())
+		w.WriteHeader(code)
+	})
+}
+
+// RedirectLoop starts a server whose returned URL redirects, with 302 Found,
+// from LoopFirstPath to LoopSecondPath and back again forever. http.Client
+// stops following after ten redirects.
+func RedirectLoop(t testing.TB) *url.URL {
+	t.Helper()
+	u := start(t, func(w http.ResponseWriter, r *http.Request, _ <-chan struct{}) {
+		next := LoopFirstPath
+		if r.URL.Path == LoopFirstPath {
+			next = LoopSecondPath
+		}
+		http.Redirect(w, r, next, http.StatusFound)
+	})
+	u.Path = LoopFirstPath
+	return u
+}
+
+// Reset starts a server that reads the request and then aborts the TCP
+// connection with a reset instead of answering. Clients usually see
+// "connection reset by peer", though some platforms report io.EOF.
+func Reset(t testing.TB) *url.URL {
+	t.Helper()
+	return start(t, func(w http.ResponseWriter, _ *http.Request, _ <-chan struct{}) {
+		conn := hijack(w)
+		if tcp, ok := conn.(*net.TCPConn); ok {
+			// A zero linger time makes Close send RST rather than FIN.
+			_ = tcp.SetLinger(0)
+		}
+		_ = conn.Close()
+	})
+}
+
+// TruncatedBody starts a server that answers 200 OK with a Content-Length of
+// PartialBodyLength, sends only the PartialBody bytes, and closes the
+// connection. Reading the body fails with io.ErrUnexpectedEOF.
+func TruncatedBody(t testing.TB) *url.URL {
+	t.Helper()
+	return start(t, func(w http.ResponseWriter, _ *http.Request, _ <-chan struct{}) {
+		conn := hijack(w)
+		defer conn.Close()
+		fmt.Fprintf(conn, "HTTP/1.1 200 OK\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s",
+			PartialBodyLength, PartialBody)
+	})
+}
+
+// TLS starts an HTTPS server with a self-signed certificate that answers
+// every request with 200 OK. Clients that verify certificates, including
+// http.DefaultClient, reject it; use TLSWithClient for one that trusts it.
+func TLS(t testing.TB) *url.URL {
+	t.Helper()
+	u, _ := TLSWithClient(t)
+	return u
+}
+
+// TLSWithClient is like TLS but also returns an *http.Client configured to
+// trust the server's certificate.
+func TLSWithClient(t testing.TB) (*url.URL, *http.Client) {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, http.StatusText(http.StatusOK))
+		}),
+	)
+	// Rejected handshakes are the point of the fixture, so keep them out of
+	// the test output.
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return testurl.MustParse(srv.URL), srv.Client()
+}
+
+// handler is an http.HandlerFunc that also receives a channel closed when
+// the test ends, so handlers that block can return.
+type handler func(w http.ResponseWriter, r *http.Request, stop <-chan struct{})
+
+// start runs h on a new loopback server and registers its shutdown.
+func start(t testing.TB, h handler) *url.URL {
+	t.Helper()
+
+	stop := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h(w, r, stop)
+	}))
+	t.Cleanup(func() {
+		close(stop)
+		srv.Close()
+	})
+	return testurl.MustParse(srv.URL)
+}
+
+// hijack takes over the connection behind w. The fixtures using it run on
+// HTTP/1.1 servers, where hijacking is always supported.
+func hijack(w http.ResponseWriter) net.Conn {
+	conn, _, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		panic(fmt.Sprintf("server: failed to hijack connection: %v", err))
+	}
+	return conn
+}
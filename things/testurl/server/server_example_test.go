@@ -0,0 +1,75 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func ExampleStatus() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	res, err := http.Get(Status(t, http.StatusServiceUnavailable).String())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+
+	fmt.Println(res.StatusCode)
+	fmt.Println(string(body))
+	// Output:
+	// 503
+	// Service Unavailable
+}
+
+func ExampleRedirect() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	target := OK(t)
+	res, err := http.Get(Redirect(t, http.StatusMovedPermanently, target).String())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer res.Body.Close()
+
+	fmt.Println(res.Request.URL.String() == target.String())
+	// Output: true
+}
+
+func ExampleTruncatedBody() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	res, err := http.Get(TruncatedBody(t).String())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+
+	fmt.Println(string(body))
+	fmt.Println(errors.Is(err, io.ErrUnexpectedEOF))
+	// Output:
+	// partial
+	// true
+}
+
+func ExampleTLSWithClient() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	u, client := TLSWithClient(t)
+	res, err := client.Get(u.String())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer res.Body.Close()
+
+	fmt.Println(res.StatusCode)
+	// Output: 200
+}
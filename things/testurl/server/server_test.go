@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/madflojo/testlazy/things/testurl"
+)
+
+// get fetches u with a fresh client so connections are never shared between
+// fixtures.
+func get(t *testing.T, client *http.Client, u *url.URL) (*http.Response, error) {
+	t.Helper()
+	if client == nil {
+		client = &http.Client{Transport: &http.Transport{}}
+	}
+	t.Cleanup(client.CloseIdleConnections)
+	return client.Get(u.String())
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		fn   func(testing.TB) *url.URL
+		code int
+	}{
+		{"OK", OK, http.StatusOK},
+		{
+			"Teapot",
+			func(t testing.TB) *url.URL { return Status(t, http.StatusTeapot) },
+			http.StatusTeapot,
+		},
+		{
+			"Unavailable",
+			func(t testing.TB) *url.URL { return Status(t, http.StatusServiceUnavailable) },
+			http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := tc.fn(t)
+			if u.Scheme != "http" || u.Hostname() != testurl.IPv4LoopbackHost {
+				t.Errorf("Expected a loopback http URL, got %q", u)
+			}
+
+			resp, err := get(t, nil, u)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tc.code || string(body) != http.StatusText(tc.code) {
+				t.Errorf(
+					"Expected %d %q, got %d %q",
+					tc.code,
+					http.StatusText(tc.code),
+					resp.StatusCode,
+					body,
+				)
+			}
+		})
+	}
+}
+
+func TestSlow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Waits", func(t *testing.T) {
+		t.Parallel()
+		u := Slow(t, 50*time.Millisecond)
+		start := time.Now()
+		resp, err := get(t, nil, u)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+			t.Errorf("Expected response after at least 50ms, got %s", elapsed)
+		}
+	})
+
+	t.Run("Client gives up", func(t *testing.T) {
+		t.Parallel()
+		u := Slow(t, time.Hour)
+		_, err := get(t, &http.Client{Timeout: 20 * time.Millisecond}, u)
+		if err == nil {
+			t.Fatal("Expected a timeout error")
+		}
+	})
+}
+
+func TestHang(t *testing.T) {
+	t.Parallel()
+	u := Hang(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: &http.Transport{}}
+	defer client.CloseIdleConnections()
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestHangReleasedAtCleanup(t *testing.T) {
+	t.Parallel()
+
+	// A client without a timeout must not keep the fixture from shutting
+	// down once the subtest ends.
+	errs := make(chan error, 1)
+	t.Run("Fixture", func(t *testing.T) {
+		u := Hang(t)
+		go func() {
+			_, err := http.Get(u.String())
+			errs <- err
+		}()
+		time.Sleep(20 * time.Millisecond)
+	})
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Error("Expected the request to fail once the server closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Request still hanging after cleanup")
+	}
+}
+
+func TestRedirect(t *testing.T) {
+	t.Parallel()
+	target := OK(t)
+	u := Redirect(t, http.StatusTemporaryRedirect, target)
+
+	client := &http.Client{
+		Transport: &http.Transport{},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := get(t, client, u)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTemporaryRedirect {
+		t.Errorf("Expected status %d, got %d", http.StatusTemporaryRedirect, resp.StatusCode)
+	}
+	if resp.Header.Get("Location") != target.String() {
+		t.Errorf("Expected Location %q, got %q", target, resp.Header.Get("Location"))
+	}
+
+	resp, err = get(t, nil, u)
+	if err != nil {
+		t.Fatalf("Unexpected error following redirect: %v", err)
+	}
+	resp.Body.Close()
+	if resp.Request.URL.String() != target.String() {
+		t.Errorf("Expected to end at %q, got %q", target, resp.Request.URL)
+	}
+}
+
+func TestRedirectLoop(t *testing.T) {
+	t.Parallel()
+	u := RedirectLoop(t)
+	if u.Path != LoopFirstPath {
+		t.Errorf("Expected path %q, got %q", LoopFirstPath, u.Path)
+	}
+
+	var visited []string
+	client := &http.Client{
+		Transport: &http.Transport{},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			visited = append(visited, req.URL.Path)
+			if len(via) >= 4 {
+				return errors.New("loop")
+			}
+			return nil
+		},
+	}
+	if _, err := get(t, client, u); err == nil || !strings.Contains(err.Error(), "loop") {
+		t.Fatalf("Expected the loop to be stopped, got %v", err)
+	}
+	want := []string{LoopSecondPath, LoopFirstPath, LoopSecondPath, LoopFirstPath}
+	if strings.Join(visited, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected redirects %q, got %q", want, visited)
+	}
+
+	if _, err := get(t, nil, u); err == nil ||
+		!strings.Contains(err.Error(), "stopped after 10 redirects") {
+		t.Errorf("Expected the default client to give up, got %v", err)
+	}
+}
+
+func TestReset(t *testing.T) {
+	t.Parallel()
+	u := Reset(t)
+	if resp, err := get(t, nil, u); err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected a connection error")
+	}
+}
+
+func TestTruncatedBody(t *testing.T) {
+	t.Parallel()
+	u := TruncatedBody(t)
+
+	resp, err := get(t, nil, u)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.ContentLength != PartialBodyLength {
+		t.Errorf("Expected Content-Length %d, got %d", PartialBodyLength, resp.ContentLength)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected io.ErrUnexpectedEOF, got %v", err)
+	}
+	if string(body) != PartialBody {
+		t.Errorf("Expected body %q, got %q", PartialBody, body)
+	}
+}
+
+func TestTLS(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Untrusted", func(t *testing.T) {
+		t.Parallel()
+		u := TLS(t)
+		if u.Scheme != "https" {
+			t.Errorf("Expected https URL, got %q", u)
+		}
+		_, err := get(t, nil, u)
+		var unknown x509.UnknownAuthorityError
+		if !errors.As(err, &unknown) {
+			t.Fatalf("Expected x509.UnknownAuthorityError, got %v", err)
+		}
+	})
+
+	t.Run("Trusted client", func(t *testing.T) {
+		t.Parallel()
+		u, client := TLSWithClient(t)
+		resp, err := get(t, client, u)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,367 @@
+/*
+Package ssrf catalogs server-side request forgery payloads: URLs that point at
+cloud metadata services, loopback, and other internal addresses, often in a
+form designed to slip past naive allowlist checks.
+
+	github.com/madflojo/testlazy/things/testurl/ssrf
+
+Every Payload is tagged with the Class of trick it uses and the internal
+Target it ultimately reaches, so a single table test can assert that outbound
+request code blocks all of them.
+
+Example usage
+
+	for _, p := range ssrf.Payloads() {
+	    t.Run(p.Name, func(t *testing.T) {
+	        if allowed(p.URL()) {
+	            t.Errorf("%s payload %q was allowed", p.Class, p.Raw)
+	        }
+	    })
+	}
+
+The payloads are test inputs only. Some use public wildcard DNS services that
+resolve to internal addresses, so never fetch them from a test.
+*/
+package ssrf
+
+import (
+	"net/url"
+
+	"github.com/madflojo/testlazy/things/testurl"
+	"github.com/madflojo/testlazy/things/testurl/internal/catalog"
+)
+
+// Class identifies the technique a Payload uses to reach an internal target.
+type Class string
+
+const (
+	// ClassMetadata targets a cloud metadata endpoint by its usual address or name.
+	ClassMetadata Class = "metadata"
+
+	// ClassEncodedIP writes an IPv4 address in decimal, octal, hex, or short form.
+	ClassEncodedIP Class = "encoded-ip"
+
+	// ClassIPv6Mapped wraps an internal IPv4 address in an IPv4-mapped IPv6 literal.
+	ClassIPv6Mapped Class = "ipv6-mapped"
+
+	// ClassLoopback uses localhost or another loopback alias.
+	ClassLoopback Class = "loopback"
+
+	// ClassUnspecified uses the unspecified address, which most stacks route to loopback.
+	ClassUnspecified Class = "unspecified"
+
+	// ClassUserinfo hides the real host behind userinfo or a fragment that looks like a host.
+	ClassUserinfo Class = "userinfo"
+
+	// ClassRedirect points at an allowed host that redirects to an internal target.
+	ClassRedirect Class = "redirect"
+
+	// ClassDNSRebinding uses a hostname whose DNS answer is an internal address.
+	ClassDNSRebinding Class = "dns-rebinding"
+
+	// ClassHostCase changes the case of a blocked name or adds a trailing dot.
+	ClassHostCase Class = "host-case"
+)
+
+// Targets reached by the payloads.
+const (
+	MetadataIPv4 = "169.254.169.254"
+	MetadataIPv6 = "fd00:ec2::254"
+	MetadataHost = "metadata.google.internal"
+	LoopbackIPv4 = "127.0.0.1"
+	LoopbackIPv6 = "::1"
+	Unspecified  = "0.0.0.0"
+)
+
+// Payload is a single SSRF test input.
+type Payload struct {
+	// Name briefly describes the payload.
+	Name string
+
+	// Raw is the URL string exactly as an attacker would supply it.
+	Raw string
+
+	// Class is the technique the payload uses.
+	Class Class
+
+	// Target is the internal address or name the payload reaches.
+	Target string
+}
+
+// URL returns a new *url.URL parsed from Raw.
+func (p Payload) URL() *url.URL {
+	return testurl.MustParse(p.Raw)
+}
+
+// Payloads returns a fresh copy of the full catalog.
+func Payloads() []Payload {
+	return []Payload{
+		// Metadata endpoints.
+		{
+			Name:   "AWS metadata",
+			Raw:    "http://169.254.169.254/latest/meta-data/",
+			Class:  ClassMetadata,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "AWS IPv6 metadata",
+			Raw:    "http://[fd00:ec2::254]/latest/meta-data/",
+			Class:  ClassMetadata,
+			Target: MetadataIPv6,
+		},
+		{
+			Name:   "GCP metadata",
+			Raw:    "http://metadata.google.internal/computeMetadata/v1/",
+			Class:  ClassMetadata,
+			Target: MetadataHost,
+		},
+		{
+			Name:   "Alibaba metadata",
+			Raw:    "http://100.100.100.200/latest/meta-data/",
+			Class:  ClassMetadata,
+			Target: "100.100.100.200",
+		},
+
+		// Alternate IPv4 encodings.
+		{
+			Name:   "metadata decimal",
+			Raw:    "http://2852039166/",
+			Class:  ClassEncodedIP,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "metadata octal",
+			Raw:    "http://0251.0376.0251.0376/",
+			Class:  ClassEncodedIP,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "metadata hex",
+			Raw:    "http://0xa9fea9fe/",
+			Class:  ClassEncodedIP,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "metadata dotted hex",
+			Raw:    "http://0xa9.0xfe.0xa9.0xfe/",
+			Class:  ClassEncodedIP,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "metadata short form",
+			Raw:    "http://169.254.43518/",
+			Class:  ClassEncodedIP,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "loopback decimal",
+			Raw:    "http://2130706433/",
+			Class:  ClassEncodedIP,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "loopback octal",
+			Raw:    "http://017700000001/",
+			Class:  ClassEncodedIP,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "loopback hex",
+			Raw:    "http://0x7f000001/",
+			Class:  ClassEncodedIP,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "loopback short form",
+			Raw:    "http://127.1/",
+			Class:  ClassEncodedIP,
+			Target: LoopbackIPv4,
+		},
+
+		// IPv4-mapped IPv6.
+		{
+			Name:   "metadata mapped",
+			Raw:    "http://[::ffff:169.254.169.254]/",
+			Class:  ClassIPv6Mapped,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "metadata mapped hex",
+			Raw:    "http://[::ffff:a9fe:a9fe]/",
+			Class:  ClassIPv6Mapped,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "metadata mapped expanded",
+			Raw:    "http://[0:0:0:0:0:ffff:169.254.169.254]/",
+			Class:  ClassIPv6Mapped,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "loopback mapped",
+			Raw:    "http://[::ffff:127.0.0.1]/",
+			Class:  ClassIPv6Mapped,
+			Target: LoopbackIPv4,
+		},
+
+		// Loopback aliases.
+		{Name: "localhost", Raw: "http://localhost/", Class: ClassLoopback, Target: LoopbackIPv4},
+		{
+			Name:   "localhost with port",
+			Raw:    "http://localhost:8080/",
+			Class:  ClassLoopback,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "loopback IPv4",
+			Raw:    "http://127.0.0.1/",
+			Class:  ClassLoopback,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "loopback range",
+			Raw:    "http://127.127.127.127/",
+			Class:  ClassLoopback,
+			Target: "127.127.127.127",
+		},
+		{Name: "loopback IPv6", Raw: "http://[::1]/", Class: ClassLoopback, Target: LoopbackIPv6},
+		{
+			Name:   "localhost subdomain",
+			Raw:    "http://app.localhost/",
+			Class:  ClassLoopback,
+			Target: LoopbackIPv4,
+		},
+
+		// Unspecified addresses.
+		{
+			Name:   "unspecified IPv4",
+			Raw:    "http://0.0.0.0/",
+			Class:  ClassUnspecified,
+			Target: Unspecified,
+		},
+		{
+			Name:   "unspecified short form",
+			Raw:    "http://0/",
+			Class:  ClassUnspecified,
+			Target: Unspecified,
+		},
+		{Name: "unspecified IPv6", Raw: "http://[::]/", Class: ClassUnspecified, Target: "::"},
+
+		// Userinfo and fragment confusion.
+		{
+			Name:   "userinfo host",
+			Raw:    "http://example.com@127.0.0.1/",
+			Class:  ClassUserinfo,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "userinfo host and port",
+			Raw:    "http://example.com:80@169.254.169.254/",
+			Class:  ClassUserinfo,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "fragment host",
+			Raw:    "http://127.0.0.1#@example.com/",
+			Class:  ClassUserinfo,
+			Target: LoopbackIPv4,
+		},
+
+		// Redirect bait on an otherwise allowed host.
+		{
+			Name:   "redirect parameter",
+			Raw:    "http://example.com/redirect?url=http://169.254.169.254/",
+			Class:  ClassRedirect,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "encoded redirect parameter",
+			Raw:    "http://example.com/redirect?url=http%3A%2F%2F127.0.0.1%2F",
+			Class:  ClassRedirect,
+			Target: LoopbackIPv4,
+		},
+
+		// Hostnames that resolve to internal addresses.
+		{
+			Name:   "wildcard DNS metadata",
+			Raw:    "http://169.254.169.254.nip.io/",
+			Class:  ClassDNSRebinding,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "wildcard DNS loopback",
+			Raw:    "http://127.0.0.1.nip.io/",
+			Class:  ClassDNSRebinding,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "rebinding DNS",
+			Raw:    "http://7f000001.a9fea9fe.rbndr.us/",
+			Class:  ClassDNSRebinding,
+			Target: MetadataIPv4,
+		},
+		{
+			Name:   "public name for loopback",
+			Raw:    "http://localtest.me/",
+			Class:  ClassDNSRebinding,
+			Target: LoopbackIPv4,
+		},
+
+		// Case and trailing-dot variants.
+		{
+			Name:   "uppercase localhost",
+			Raw:    "http://LOCALHOST/",
+			Class:  ClassHostCase,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "mixed-case localhost",
+			Raw:    "http://LocalHost/",
+			Class:  ClassHostCase,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "trailing-dot localhost",
+			Raw:    "http://localhost./",
+			Class:  ClassHostCase,
+			Target: LoopbackIPv4,
+		},
+		{
+			Name:   "uppercase metadata",
+			Raw:    "http://METADATA.GOOGLE.INTERNAL/",
+			Class:  ClassHostCase,
+			Target: MetadataHost,
+		},
+		{
+			Name:   "trailing-dot metadata",
+			Raw:    "http://metadata.google.internal./",
+			Class:  ClassHostCase,
+			Target: MetadataHost,
+		},
+	}
+}
+
+// Strings returns the Raw form of every payload.
+func Strings() []string {
+	return catalog.Strings(Payloads(), func(p Payload) string { return p.Raw })
+}
+
+// ByClass returns the payloads tagged with any of the given classes.
+func ByClass(classes ...Class) []Payload {
+	return catalog.ByClass(Payloads(), func(p Payload) Class { return p.Class }, classes)
+}
+
+// Classes returns every Class used in the catalog, in catalog order.
+func Classes() []Class {
+	return []Class{
+		ClassMetadata,
+		ClassEncodedIP,
+		ClassIPv6Mapped,
+		ClassLoopback,
+		ClassUnspecified,
+		ClassUserinfo,
+		ClassRedirect,
+		ClassDNSRebinding,
+		ClassHostCase,
+	}
+}
@@ -0,0 +1,17 @@
+package ssrf_test
+
+import (
+	"fmt"
+
+	"github.com/madflojo/testlazy/things/testurl/ssrf"
+)
+
+func ExampleByClass() {
+	for _, p := range ssrf.ByClass(ssrf.ClassUserinfo) {
+		fmt.Printf("%s -> %s\n", p.Raw, p.URL().Hostname())
+	}
+	// Output:
+	// http://example.com@127.0.0.1/ -> 127.0.0.1
+	// http://example.com:80@169.254.169.254/ -> 169.254.169.254
+	// http://127.0.0.1#@example.com/ -> 127.0.0.1
+}
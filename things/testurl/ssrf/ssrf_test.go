@@ -0,0 +1,130 @@
+package ssrf
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPayloads(t *testing.T) {
+	t.Parallel()
+
+	known := make(map[Class]bool)
+	for _, c := range Classes() {
+		known[c] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range Payloads() {
+		if seen[p.Name] {
+			t.Fatalf("Duplicate payload name %q", p.Name)
+		}
+		seen[p.Name] = true
+
+		t.Run(p.Name, func(t *testing.T) {
+			t.Parallel()
+			if !known[p.Class] {
+				t.Errorf("Unknown class %q", p.Class)
+			}
+			if p.Target == "" {
+				t.Error("Expected a target")
+			}
+			if got := p.URL().String(); got != p.Raw {
+				t.Errorf("Expected URL to round-trip to %q, got %q", p.Raw, got)
+			}
+		})
+	}
+}
+
+func TestEveryClassHasPayloads(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range Classes() {
+		if len(ByClass(c)) == 0 {
+			t.Errorf("Expected payloads for class %q", c)
+		}
+	}
+}
+
+func TestByClass(t *testing.T) {
+	t.Parallel()
+
+	got := ByClass(ClassLoopback, ClassUnspecified)
+	want := len(ByClass(ClassLoopback)) + len(ByClass(ClassUnspecified))
+	if len(got) != want {
+		t.Fatalf("Expected %d payloads, got %d", want, len(got))
+	}
+	for _, p := range got {
+		if p.Class != ClassLoopback && p.Class != ClassUnspecified {
+			t.Errorf("Unexpected class %q for %q", p.Class, p.Name)
+		}
+	}
+	if len(ByClass()) != 0 {
+		t.Error("Expected no payloads without a class")
+	}
+}
+
+func TestStrings(t *testing.T) {
+	t.Parallel()
+
+	payloads := Payloads()
+	strs := Strings()
+	if len(strs) != len(payloads) {
+		t.Fatalf("Expected %d strings, got %d", len(payloads), len(strs))
+	}
+	for i, s := range strs {
+		if s != payloads[i].Raw {
+			t.Errorf("Expected %q at %d, got %q", payloads[i].Raw, i, s)
+		}
+	}
+}
+
+// TestLiteralTargets verifies that payloads whose host is an address literal
+// actually reach their Target.
+func TestLiteralTargets(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range ByClass(ClassEncodedIP, ClassIPv6Mapped, ClassUserinfo, ClassUnspecified) {
+		t.Run(p.Name, func(t *testing.T) {
+			t.Parallel()
+			addr, ok := parseLegacyIP(p.URL().Hostname())
+			if !ok {
+				t.Fatalf("Expected %q to be an address literal", p.URL().Hostname())
+			}
+			if got := addr.Unmap().String(); got != p.Target {
+				t.Errorf("Expected target %q, got %q", p.Target, got)
+			}
+		})
+	}
+}
+
+// parseLegacyIP parses an address the way inet_aton does, accepting decimal,
+// octal, and hex parts and the short a, a.b, and a.b.c forms.
+func parseLegacyIP(host string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, true
+	}
+
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+	nums := make([]uint64, len(parts))
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 0, 32)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		nums[i] = n
+	}
+
+	// The last part fills every remaining byte.
+	var v uint64
+	for i, n := range nums[:len(nums)-1] {
+		v |= n << (24 - 8*i)
+	}
+	v |= nums[len(nums)-1]
+
+	return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}), true
+}
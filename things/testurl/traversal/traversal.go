@@ -0,0 +1,161 @@
+/*
+Package traversal catalogs path-traversal payloads: request paths that try to
+climb out of a static-file root to reach files such as /etc/passwd.
+
+	github.com/madflojo/testlazy/things/testurl/traversal
+
+Every Payload is tagged with the Class of trick it uses. Path is the raw,
+still-escaped segment appended to the served prefix, Raw is the full URL
+string, and URL returns it parsed, so file servers can be tested at whichever
+layer they decode input.
+
+Example usage
+
+	for _, p := range traversal.Payloads() {
+	    t.Run(p.Name, func(t *testing.T) {
+	        req := httptest.NewRequest(http.MethodGet, p.Raw, nil)
+	        rec := httptest.NewRecorder()
+	        handler.ServeHTTP(rec, req)
+	        if rec.Code == http.StatusOK {
+	            t.Errorf("%s payload %q was served", p.Class, p.Raw)
+	        }
+	    })
+	}
+*/
+package traversal
+
+import (
+	"net/url"
+
+	"github.com/madflojo/testlazy/things/testurl"
+	"github.com/madflojo/testlazy/things/testurl/internal/catalog"
+)
+
+// Prefix is the served directory every payload starts from.
+const Prefix = "/static/"
+
+// Class identifies the technique a Payload uses to escape Prefix.
+type Class string
+
+const (
+	// ClassDotDot uses plain "../" segments.
+	ClassDotDot Class = "dot-dot"
+
+	// ClassEncoded percent-encodes the dots, the slashes, or both.
+	ClassEncoded Class = "encoded"
+
+	// ClassDoubleEncoded percent-encodes the percent signs as well, so a
+	// second decode produces "../".
+	ClassDoubleEncoded Class = "double-encoded"
+
+	// ClassOverlongUTF8 encodes "." or "/" as an invalid overlong UTF-8
+	// sequence that lenient decoders accept.
+	ClassOverlongUTF8 Class = "overlong-utf8"
+
+	// ClassNullByte truncates the path with an encoded NUL so an extension
+	// check sees a harmless suffix.
+	ClassNullByte Class = "null-byte"
+
+	// ClassBackslash uses Windows path separators.
+	ClassBackslash Class = "backslash"
+
+	// ClassFilterBypass defeats filters that strip "../" once or stop at ";".
+	ClassFilterBypass Class = "filter-bypass"
+)
+
+// Payload is a single path-traversal test input.
+type Payload struct {
+	// Name briefly describes the payload.
+	Name string
+
+	// Path is the escaped payload appended to Prefix.
+	Path string
+
+	// Class is the technique the payload uses.
+	Class Class
+
+	// Raw is the full URL string, "http://example.com/static/" followed by
+	// Path.
+	Raw string
+}
+
+// URL returns a new *url.URL parsed from Raw. Every payload parses, but
+// net/url escapes literal backslashes, so URL().String() may not equal Raw.
+func (p Payload) URL() *url.URL {
+	return testurl.MustParse(p.Raw)
+}
+
+// Payloads returns a fresh copy of the full catalog.
+func Payloads() []Payload {
+	payloads := []Payload{
+		{Name: "dot-dot passwd", Path: "../../../../etc/passwd", Class: ClassDotDot},
+		{Name: "dot-dot win.ini", Path: "../../../../windows/win.ini", Class: ClassDotDot},
+		{Name: "dot segments", Path: "./.././../etc/passwd", Class: ClassDotDot},
+
+		{Name: "encoded everything", Path: "%2e%2e%2f%2e%2e%2fetc%2fpasswd", Class: ClassEncoded},
+		{Name: "encoded uppercase", Path: "%2E%2E%2F%2E%2E%2Fetc%2Fpasswd", Class: ClassEncoded},
+		{Name: "encoded slash", Path: "..%2f..%2fetc%2fpasswd", Class: ClassEncoded},
+		{Name: "encoded dots", Path: "%2e%2e/%2e%2e/etc/passwd", Class: ClassEncoded},
+
+		{
+			Name:  "double-encoded everything",
+			Path:  "%252e%252e%252f%252e%252e%252fetc%252fpasswd",
+			Class: ClassDoubleEncoded,
+		},
+		{
+			Name:  "double-encoded slash",
+			Path:  "..%252f..%252fetc%252fpasswd",
+			Class: ClassDoubleEncoded,
+		},
+
+		{
+			Name:  "overlong dots and slash",
+			Path:  "%c0%ae%c0%ae%c0%af%c0%ae%c0%ae%c0%afetc%c0%afpasswd",
+			Class: ClassOverlongUTF8,
+		},
+		{Name: "overlong slash", Path: "..%c0%af..%c0%afetc%c0%afpasswd", Class: ClassOverlongUTF8},
+		{
+			Name:  "three-byte overlong dots",
+			Path:  "%e0%80%ae%e0%80%ae/%e0%80%ae%e0%80%ae/etc/passwd",
+			Class: ClassOverlongUTF8,
+		},
+
+		{Name: "null byte extension", Path: "../../etc/passwd%00.png", Class: ClassNullByte},
+		{Name: "null byte directory", Path: "../../etc/passwd%00/", Class: ClassNullByte},
+
+		{Name: "backslash", Path: `..\..\etc\passwd`, Class: ClassBackslash},
+		{Name: "encoded backslash", Path: "..%5c..%5cetc%5cpasswd", Class: ClassBackslash},
+		{Name: "mixed separators", Path: `../..\../etc/passwd`, Class: ClassBackslash},
+
+		{Name: "nested dot-dot", Path: "....//....//etc/passwd", Class: ClassFilterBypass},
+		{Name: "nested dot-dot-slash", Path: "..././..././etc/passwd", Class: ClassFilterBypass},
+		{Name: "semicolon segment", Path: "..;/..;/etc/passwd", Class: ClassFilterBypass},
+	}
+	for i := range payloads {
+		payloads[i].Raw = "http://" + testurl.ExampleHost + Prefix + payloads[i].Path
+	}
+	return payloads
+}
+
+// Strings returns the Raw form of every payload.
+func Strings() []string {
+	return catalog.Strings(Payloads(), func(p Payload) string { return p.Raw })
+}
+
+// ByClass returns the payloads tagged with any of the given classes.
+func ByClass(classes ...Class) []Payload {
+	return catalog.ByClass(Payloads(), func(p Payload) Class { return p.Class }, classes)
+}
+
+// Classes returns every Class used in the catalog, in catalog order.
+func Classes() []Class {
+	return []Class{
+		ClassDotDot,
+		ClassEncoded,
+		ClassDoubleEncoded,
+		ClassOverlongUTF8,
+		ClassNullByte,
+		ClassBackslash,
+		ClassFilterBypass,
+	}
+}
@@ -0,0 +1,16 @@
+package traversal_test
+
+import (
+	"fmt"
+
+	"github.com/madflojo/testlazy/things/testurl/traversal"
+)
+
+func ExampleByClass() {
+	for _, p := range traversal.ByClass(traversal.ClassDoubleEncoded) {
+		fmt.Println(p.URL().Path)
+	}
+	// Output:
+	// /static/%2e%2e%2f%2e%2e%2fetc%2fpasswd
+	// /static/..%2f..%2fetc%2fpasswd
+}
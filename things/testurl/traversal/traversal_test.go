@@ -0,0 +1,107 @@
+package traversal
+
+import (
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestPayloads(t *testing.T) {
+	t.Parallel()
+
+	known := make(map[Class]bool)
+	for _, c := range Classes() {
+		known[c] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range Payloads() {
+		if seen[p.Name] {
+			t.Fatalf("Duplicate payload name %q", p.Name)
+		}
+		seen[p.Name] = true
+
+		t.Run(p.Name, func(t *testing.T) {
+			t.Parallel()
+			if !known[p.Class] {
+				t.Errorf("Unknown class %q", p.Class)
+			}
+			if !strings.HasSuffix(p.Raw, Prefix+p.Path) {
+				t.Errorf("Expected Raw %q to end with %q", p.Raw, Prefix+p.Path)
+			}
+			if u := p.URL(); !strings.HasPrefix(u.Path, Prefix) {
+				t.Errorf("Expected parsed path to start with %q, got %q", Prefix, u.Path)
+			}
+			if !escapesPrefix(p.Path) {
+				t.Errorf("Expected payload to escape %q", Prefix)
+			}
+		})
+	}
+}
+
+func TestEveryClassHasPayloads(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range Classes() {
+		if len(ByClass(c)) == 0 {
+			t.Errorf("Expected payloads for class %q", c)
+		}
+	}
+}
+
+func TestByClass(t *testing.T) {
+	t.Parallel()
+
+	got := ByClass(ClassEncoded, ClassNullByte)
+	want := len(ByClass(ClassEncoded)) + len(ByClass(ClassNullByte))
+	if len(got) != want {
+		t.Fatalf("Expected %d payloads, got %d", want, len(got))
+	}
+	if len(ByClass()) != 0 {
+		t.Error("Expected no payloads without a class")
+	}
+}
+
+func TestStrings(t *testing.T) {
+	t.Parallel()
+
+	payloads := Payloads()
+	strs := Strings()
+	if len(strs) != len(payloads) {
+		t.Fatalf("Expected %d strings, got %d", len(payloads), len(strs))
+	}
+	for i, s := range strs {
+		if s != payloads[i].Raw {
+			t.Errorf("Expected %q at %d, got %q", payloads[i].Raw, i, s)
+		}
+	}
+}
+
+// escapesPrefix reports whether p reaches outside Prefix on a careless file
+// server: one that decodes twice, accepts overlong UTF-8, treats backslashes
+// as separators, stops at NUL, ignores ";" parameters, and may strip "../"
+// once as a filter before cleaning the joined path.
+func escapesPrefix(p string) bool {
+	replacer := strings.NewReplacer(
+		"%25", "%",
+		"%2e", ".", "%2E", ".",
+		"%2f", "/", "%2F", "/",
+		"%5c", "/", "%5C", "/",
+		"%c0%ae", ".", "%c0%af", "/",
+		"%e0%80%ae", ".",
+		"%00", "\x00",
+		`\`, "/",
+		";", "",
+	)
+	p = replacer.Replace(replacer.Replace(p))
+	if i := strings.IndexByte(p, 0); i >= 0 {
+		p = p[:i]
+	}
+
+	for _, candidate := range []string{p, strings.ReplaceAll(p, "../", "")} {
+		if !strings.HasPrefix(path.Clean(Prefix+candidate)+"/", Prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -12,6 +12,10 @@ Example usage
 	    URL:    testurl.URLHTTPS(),
 	}
 
+When no predefined helper fits, build one without string concatenation:
+
+	u := testurl.New().Host(testurl.LocalhostHost).Port("9000").Query("page", "2").URL()
+
 Your tests deserve clarity and speed—let testurl handle the URL creation work.
 */
 package testurl
@@ -28,14 +32,16 @@ const (
 	HTTPSPort     = "8443"
 )
 
-// URLHTTPBad returns a *url.URL for "http://bad-url" which is technically valid but "should" not resolve correctly.
+// URLHTTPBad returns a *url.URL for "http://bad-url.invalid" which is technically valid but never resolves,
+// since the .invalid top-level domain is reserved by RFC 2606.
 func URLHTTPBad() *url.URL {
-	return MalformedURL("http", "bad-url")
+	return MalformedURL("http", BadHost)
 }
 
-// URLHTTPSBad returns a *url.URL for "https://bad-url" which is technically valid but "should" not resolve correctly.
+// URLHTTPSBad returns a *url.URL for "https://bad-url.invalid" which is technically valid but never resolves,
+// since the .invalid top-level domain is reserved by RFC 2606.
 func URLHTTPSBad() *url.URL {
-	return MalformedURL("https", "bad-url")
+	return MalformedURL("https", BadHost)
 }
 
 // URLNoScheme returns a *url.URL for "example.com" (no scheme).
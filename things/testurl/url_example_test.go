@@ -6,12 +6,12 @@ import (
 
 func ExampleURLHTTPBad() {
 	fmt.Println(URLHTTPBad().String())
-	// Output: http://bad-url
+	// Output: http://bad-url.invalid
 }
 
 func ExampleURLHTTPSBad() {
 	fmt.Println(URLHTTPSBad().String())
-	// Output: https://bad-url
+	// Output: https://bad-url.invalid
 }
 
 func ExampleURLNoScheme() {
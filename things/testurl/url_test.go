@@ -18,8 +18,8 @@ func TestPredefinedURLFunctions(t *testing.T) {
 		fn       func() *url.URL
 		expected string
 	}{
-		{"URLHTTPBad", URLHTTPBad, "http://bad-url"},
-		{"URLHTTPSBad", URLHTTPSBad, "https://bad-url"},
+		{"URLHTTPBad", URLHTTPBad, "http://bad-url.invalid"},
+		{"URLHTTPSBad", URLHTTPSBad, "https://bad-url.invalid"},
 		{"URLNoScheme", URLNoScheme, "//" + testExampleHost},
 		{"URLNoHost", URLNoHost, "http:"},
 		{"URLInvalidHost", URLInvalidHost, "http://%E0%A4%A4%E0%A4%A5"},
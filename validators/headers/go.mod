@@ -0,0 +1,3 @@
+module github.com/madflojo/testlazy/validators/headers
+
+go 1.24.3
@@ -0,0 +1,453 @@
+/*
+Package headers checks HTTP headers for the properties tests usually care
+about (content type, CORS, security, caching, and cookies) and explains the
+exact mismatch when one does not hold.
+
+	github.com/madflojo/testlazy/validators/headers
+
+Each property is a Check, and Require runs any number of them against an
+http.Header and reports every failure at once. The RequireX helpers are
+shorthands for a single check.
+
+Example usage
+
+	headers.Require(t, resp.Header,
+	    headers.ContentType("application/json", map[string]string{"charset": "utf-8"}),
+	    headers.SecurityHeaders(),
+	    headers.CacheControl("no-store"),
+	    headers.Cookie("session", headers.CookieAttributes{Secure: true, HttpOnly: true}),
+	)
+
+Pass resp.Header for a client response, rec.Result().Header for an
+httptest.ResponseRecorder, or r.Header for a request.
+*/
+package headers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Check inspects h and returns an error describing the mismatch, or nil.
+// Failed checks return one or more *MismatchError values, joined with
+// errors.Join when there are several.
+type Check func(h http.Header) error
+
+// MismatchError describes how one header differs from what a Check wants.
+type MismatchError struct {
+	// Header is the canonical name of the header that did not match.
+	Header string
+
+	// Detail explains the mismatch, such as `want "nosniff", got no header`.
+	Detail string
+}
+
+// Error returns the header and detail as "Header: detail".
+func (e *MismatchError) Error() string {
+	return e.Header + ": " + e.Detail
+}
+
+// mismatch returns a *MismatchError for header with a formatted detail.
+func mismatch(header, format string, args ...any) error {
+	return &MismatchError{
+		Header: http.CanonicalHeaderKey(header),
+		Detail: fmt.Sprintf(format, args...),
+	}
+}
+
+// CookieAttributes lists the Set-Cookie attributes a Cookie check requires.
+// Zero fields are not checked.
+type CookieAttributes struct {
+	// Secure requires the Secure attribute.
+	Secure bool
+
+	// HttpOnly requires the HttpOnly attribute.
+	HttpOnly bool
+
+	// SameSite requires a SameSite attribute with this mode.
+	SameSite http.SameSite
+
+	// Path requires a Path attribute with this value.
+	Path string
+
+	// Domain requires a Domain attribute with this value, ignoring a
+	// leading dot.
+	Domain string
+}
+
+// Require runs every check against h and fails the test listing each one
+// that does not hold.
+func Require(t testing.TB, h http.Header, checks ...Check) {
+	t.Helper()
+	if msg := failure(h, checks); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// failure returns the message Require fails with, or "" if every check
+// holds.
+func failure(h http.Header, checks []Check) string {
+	var failures []string
+	for _, check := range checks {
+		err := check(h)
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				failures = append(failures, e.Error())
+			}
+		} else if err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	switch len(failures) {
+	case 0:
+		return ""
+	case 1:
+		return "headers: " + failures[0]
+	default:
+		return fmt.Sprintf(
+			"headers: %d mismatches\n  - %s",
+			len(failures),
+			strings.Join(failures, "\n  - "),
+		)
+	}
+}
+
+// RequireHeader fails the test unless h has key with value among its values.
+func RequireHeader(t testing.TB, h http.Header, key, value string) {
+	t.Helper()
+	Require(t, h, Header(key, value))
+}
+
+// RequireHeaderAbsent fails the test if h has any value for key.
+func RequireHeaderAbsent(t testing.TB, h http.Header, key string) {
+	t.Helper()
+	Require(t, h, HeaderAbsent(key))
+}
+
+// RequireContentType fails the test unless the ContentType check holds.
+func RequireContentType(t testing.TB, h http.Header, mediaType string, params map[string]string) {
+	t.Helper()
+	Require(t, h, ContentType(mediaType, params))
+}
+
+// RequireCORS fails the test unless the CORS check holds.
+func RequireCORS(t testing.TB, h http.Header, origin string, methods ...string) {
+	t.Helper()
+	Require(t, h, CORS(origin, methods...))
+}
+
+// RequireSecurityHeaders fails the test unless the SecurityHeaders check
+// holds.
+func RequireSecurityHeaders(t testing.TB, h http.Header) {
+	t.Helper()
+	Require(t, h, SecurityHeaders())
+}
+
+// RequireCacheControl fails the test unless the CacheControl check holds.
+func RequireCacheControl(t testing.TB, h http.Header, directives ...string) {
+	t.Helper()
+	Require(t, h, CacheControl(directives...))
+}
+
+// RequireCookie fails the test unless the Cookie check holds.
+func RequireCookie(t testing.TB, h http.Header, name string, attrs CookieAttributes) {
+	t.Helper()
+	Require(t, h, Cookie(name, attrs))
+}
+
+// Header checks that key has value among its values. Values are compared
+// exactly.
+func Header(key, value string) Check {
+	return func(h http.Header) error {
+		got := h.Values(key)
+		for _, v := range got {
+			if v == value {
+				return nil
+			}
+		}
+		if len(got) == 0 {
+			return mismatch(key, "want %q, got no header", value)
+		}
+		return mismatch(key, "want %q, got %q", value, got)
+	}
+}
+
+// HeaderAbsent checks that key has no values.
+func HeaderAbsent(key string) Check {
+	return func(h http.Header) error {
+		if got := h.Values(key); len(got) > 0 {
+			return mismatch(key, "want no header, got %q", got)
+		}
+		return nil
+	}
+}
+
+// ContentType checks that Content-Type parses as mediaType, compared without
+// regard to case, with each of params. Parameters not listed are ignored, and
+// a charset value is compared without regard to case.
+func ContentType(mediaType string, params map[string]string) Check {
+	return func(h http.Header) error {
+		raw := h.Get("Content-Type")
+		if raw == "" {
+			return mismatch("Content-Type", "want %q, got no header", mediaType)
+		}
+		got, gotParams, err := mime.ParseMediaType(raw)
+		if err != nil {
+			return mismatch("Content-Type", "cannot parse %q: %v", raw, err)
+		}
+		if !strings.EqualFold(got, mediaType) {
+			return mismatch("Content-Type", "want media type %q, got %q in %q", mediaType, got, raw)
+		}
+		for _, k := range slices.Sorted(maps.Keys(params)) {
+			want := params[k]
+			v, ok := gotParams[strings.ToLower(k)]
+			switch {
+			case !ok:
+				return mismatch(
+					"Content-Type",
+					"want %s=%q, got no %s parameter in %q",
+					k,
+					want,
+					k,
+					raw,
+				)
+			case v == want, strings.EqualFold(k, "charset") && strings.EqualFold(v, want):
+			default:
+				return mismatch("Content-Type", "want %s=%q, got %s=%q", k, want, k, v)
+			}
+		}
+		return nil
+	}
+}
+
+// CORS checks that Access-Control-Allow-Origin allows origin, either by name
+// or with "*", and that Access-Control-Allow-Methods lists every one of
+// methods, or "*".
+func CORS(origin string, methods ...string) Check {
+	return func(h http.Header) error {
+		allowed := h.Get("Access-Control-Allow-Origin")
+		switch {
+		case allowed == "":
+			return mismatch("Access-Control-Allow-Origin", "want %q, got no header", origin)
+		case allowed != "*" && allowed != origin:
+			return mismatch("Access-Control-Allow-Origin", "want %q, got %q", origin, allowed)
+		}
+
+		if len(methods) == 0 {
+			return nil
+		}
+		listed := splitList(h.Values("Access-Control-Allow-Methods"))
+		if len(listed) == 0 {
+			return mismatch("Access-Control-Allow-Methods", "want %q, got no header", methods)
+		}
+		var missing []string
+		for _, m := range methods {
+			if !slices.Contains(listed, "*") && !slices.Contains(listed, m) {
+				missing = append(missing, m)
+			}
+		}
+		if len(missing) > 0 {
+			return mismatch("Access-Control-Allow-Methods", "missing %q in %q", missing, listed)
+		}
+		return nil
+	}
+}
+
+// SecurityHeaders checks for the baseline security headers:
+// Strict-Transport-Security with a positive max-age, X-Content-Type-Options
+// set to "nosniff", and a non-empty Content-Security-Policy. Every problem
+// is reported, not just the first.
+func SecurityHeaders() Check {
+	return func(h http.Header) error {
+		var errs []error
+
+		const hstsKey = "Strict-Transport-Security"
+		if hsts := h.Get(hstsKey); hsts == "" {
+			errs = append(errs, mismatch(hstsKey, "want a max-age, got no header"))
+		} else if age, ok := directives(hsts)["max-age"]; !ok {
+			errs = append(errs, mismatch(hstsKey, "want a max-age, got %q", hsts))
+		} else if n, err := strconv.Atoi(age); err != nil || n <= 0 {
+			errs = append(errs, mismatch(hstsKey, "want a positive max-age, got %q", age))
+		}
+
+		const nosniffKey = "X-Content-Type-Options"
+		switch nosniff := h.Get(nosniffKey); {
+		case nosniff == "":
+			errs = append(errs, mismatch(nosniffKey, `want "nosniff", got no header`))
+		case !strings.EqualFold(strings.TrimSpace(nosniff), "nosniff"):
+			errs = append(errs, mismatch(nosniffKey, `want "nosniff", got %q`, nosniff))
+		}
+
+		if strings.TrimSpace(h.Get("Content-Security-Policy")) == "" {
+			errs = append(errs, mismatch("Content-Security-Policy", "want a policy, got no header"))
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// CacheControl checks that Cache-Control holds every directive in want.
+// A bare directive such as "no-store" or "max-age" only has to be present,
+// while "max-age=60" also has to have that value. Directive names are
+// compared without regard to case.
+func CacheControl(want ...string) Check {
+	return func(h http.Header) error {
+		raw := strings.Join(h.Values("Cache-Control"), ", ")
+		if raw == "" {
+			return mismatch("Cache-Control", "want %q, got no header", want)
+		}
+		got := directives(raw)
+		for _, w := range want {
+			name, value, hasValue := strings.Cut(w, "=")
+			name = strings.ToLower(strings.TrimSpace(name))
+			v, ok := got[name]
+			switch {
+			case !ok:
+				return mismatch("Cache-Control", "missing directive %q in %q", w, raw)
+			case hasValue && v != unquote(strings.TrimSpace(value)):
+				return mismatch("Cache-Control", "want %s=%s, got %s=%s", name, value, name, v)
+			}
+		}
+		return nil
+	}
+}
+
+// Cookie checks that a Set-Cookie header sets the cookie name with attrs.
+func Cookie(name string, attrs CookieAttributes) Check {
+	return func(h http.Header) error {
+		var names []string
+		var cookie *http.Cookie
+		for _, line := range h.Values("Set-Cookie") {
+			c, err := http.ParseSetCookie(line)
+			if err != nil {
+				continue
+			}
+			names = append(names, c.Name)
+			if c.Name == name {
+				cookie = c
+			}
+		}
+		if cookie == nil {
+			if len(names) == 0 {
+				return mismatch("Set-Cookie", "want cookie %q, got no header", name)
+			}
+			return mismatch("Set-Cookie", "want cookie %q, got cookies %q", name, names)
+		}
+
+		var problems []string
+		if attrs.Secure && !cookie.Secure {
+			problems = append(problems, "missing Secure")
+		}
+		if attrs.HttpOnly && !cookie.HttpOnly {
+			problems = append(problems, "missing HttpOnly")
+		}
+		if attrs.SameSite != 0 && cookie.SameSite != attrs.SameSite {
+			problems = append(problems, fmt.Sprintf("want SameSite=%s, got %s",
+				sameSiteName(attrs.SameSite), sameSiteName(cookie.SameSite)))
+		}
+		if attrs.Path != "" && cookie.Path != attrs.Path {
+			problems = append(
+				problems,
+				fmt.Sprintf("want Path=%q, got %q", attrs.Path, cookie.Path),
+			)
+		}
+		trimDot := func(d string) string { return strings.TrimPrefix(d, ".") }
+		if attrs.Domain != "" && !strings.EqualFold(trimDot(cookie.Domain), trimDot(attrs.Domain)) {
+			problems = append(
+				problems,
+				fmt.Sprintf("want Domain=%q, got %q", attrs.Domain, cookie.Domain),
+			)
+		}
+		if len(problems) > 0 {
+			return mismatch("Set-Cookie", "cookie %q: %s", name, strings.Join(problems, ", "))
+		}
+		return nil
+	}
+}
+
+// directives parses a comma-separated list of "name" or "name=value"
+// directives, as used by Cache-Control and Strict-Transport-Security (which
+// separates with semicolons), into lowercase names and unquoted values.
+// Separators inside a quoted-string value, as in private="a,b", do not split
+// it (RFC 9110, Section 5.6.4).
+func directives(raw string) map[string]string {
+	out := make(map[string]string)
+	for _, part := range splitDirectives(raw) {
+		name, value, _ := strings.Cut(part, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" {
+			out[name] = unquote(strings.TrimSpace(value))
+		}
+	}
+	return out
+}
+
+// splitDirectives splits raw at every "," or ";" outside a quoted string.
+func splitDirectives(raw string) []string {
+	var parts []string
+	start, quoted := 0, false
+	for i := 0; i < len(raw); i++ {
+		switch c := raw[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && (c == ',' || c == ';'):
+			parts = append(parts, raw[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, raw[start:])
+}
+
+// unquote returns the content of the quoted string s with its quoted-pairs
+// resolved, or s itself when it is not quoted.
+func unquote(s string) string {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+	var b strings.Builder
+	for i := 1; i < len(s)-1; i++ {
+		if s[i] == '\\' && i+1 < len(s)-1 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// splitList splits comma-separated header values into trimmed items.
+func splitList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				out = append(out, item)
+			}
+		}
+	}
+	return out
+}
+
+// sameSiteName returns the attribute value for mode, or "unset".
+func sameSiteName(mode http.SameSite) string {
+	switch mode {
+	case http.SameSiteLaxMode:
+		return "Lax"
+	case http.SameSiteStrictMode:
+		return "Strict"
+	case http.SameSiteNoneMode:
+		return "None"
+	case http.SameSiteDefaultMode:
+		return "Default"
+	default:
+		return "unset"
+	}
+}
@@ -0,0 +1,58 @@
+package headers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ExampleRequire runs several checks against a recorded response.
+func ExampleRequire() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rec.Header().Set("Cache-Control", "no-store")
+	http.SetCookie(rec, &http.Cookie{Name: "session", Value: "abc", Secure: true, HttpOnly: true})
+	rec.WriteHeader(http.StatusOK)
+
+	Require(t, rec.Result().Header,
+		ContentType("application/json", map[string]string{"charset": "utf-8"}),
+		CacheControl("no-store"),
+		Cookie("session", CookieAttributes{Secure: true, HttpOnly: true}),
+	)
+	fmt.Println(rec.Code)
+	// Output: 200
+}
+
+// ExampleCacheControl shows the mismatch a failed check reports.
+func ExampleCacheControl() {
+	h := http.Header{"Cache-Control": {"public, max-age=300"}}
+
+	fmt.Println(CacheControl("max-age=300")(h))
+	fmt.Println(CacheControl("max-age=60")(h))
+	// Output:
+	// <nil>
+	// Cache-Control: want max-age=60, got max-age=300
+}
+
+// ExampleSecurityHeaders unwraps each problem a check reports.
+func ExampleSecurityHeaders() {
+	h := http.Header{
+		"Strict-Transport-Security": {"max-age=0"},
+		"X-Content-Type-Options":    {"nosniff"},
+	}
+
+	err := SecurityHeaders()(h)
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var mismatch *MismatchError
+		if errors.As(e, &mismatch) {
+			fmt.Printf("%s: %s\n", mismatch.Header, mismatch.Detail)
+		}
+	}
+	// Output:
+	// Strict-Transport-Security: want a positive max-age, got "0"
+	// Content-Security-Policy: want a policy, got no header
+}
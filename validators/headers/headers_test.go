@@ -0,0 +1,500 @@
+package headers
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// header builds an http.Header from key/value pairs, adding repeated keys.
+func header(pairs ...string) http.Header {
+	h := make(http.Header)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		h.Add(pairs[i], pairs[i+1])
+	}
+	return h
+}
+
+// checkCase is a single Check run against a header, with the error text it
+// should produce, or "" if it should pass.
+type checkCase struct {
+	name  string
+	check Check
+	h     http.Header
+	err   string
+}
+
+func runCases(t *testing.T, tests []checkCase) {
+	t.Helper()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.check(tc.h)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", tc.err)
+			}
+			if err.Error() != tc.err {
+				t.Errorf("Expected error:\n  %s\ngot:\n  %s", tc.err, err)
+			}
+			var m *MismatchError
+			if !errors.As(err, &m) {
+				t.Errorf("Expected a *MismatchError, got %T", err)
+			}
+		})
+	}
+}
+
+func TestHeader(t *testing.T) {
+	t.Parallel()
+	runCases(t, []checkCase{
+		{"Match", Header("x-request-id", "abc"), header("X-Request-Id", "abc"), ""},
+		{
+			"Match second value",
+			Header("Vary", "Origin"),
+			header("Vary", "Accept", "Vary", "Origin"),
+			"",
+		},
+		{
+			"Missing",
+			Header("X-Request-Id", "abc"),
+			header(),
+			`X-Request-Id: want "abc", got no header`,
+		},
+		{
+			"Different",
+			Header("Vary", "Origin"),
+			header("Vary", "Accept"),
+			`Vary: want "Origin", got ["Accept"]`,
+		},
+		{"Absent", HeaderAbsent("Server"), header(), ""},
+		{
+			"Present",
+			HeaderAbsent("server"),
+			header("Server", "nginx"),
+			`Server: want no header, got ["nginx"]`,
+		},
+	})
+}
+
+func TestContentType(t *testing.T) {
+	t.Parallel()
+	utf8 := map[string]string{"charset": "utf-8"}
+	runCases(t, []checkCase{
+		{
+			"Plain",
+			ContentType("application/json", nil),
+			header("Content-Type", "application/json"),
+			"",
+		},
+		{
+			"Case",
+			ContentType("application/json", nil),
+			header("Content-Type", "Application/JSON"),
+			"",
+		},
+		{
+			"Charset",
+			ContentType("text/html", utf8),
+			header("Content-Type", "text/html; charset=UTF-8"),
+			"",
+		},
+		{
+			"Quoted charset",
+			ContentType("text/html", utf8),
+			header("Content-Type", `text/html; Charset="utf-8"`),
+			"",
+		},
+		{
+			"Extra params",
+			ContentType("text/html", nil),
+			header("Content-Type", "text/html; charset=utf-8"),
+			"",
+		},
+		{
+			"Missing",
+			ContentType("application/json", nil),
+			header(),
+			`Content-Type: want "application/json", got no header`,
+		},
+		{
+			"Wrong type",
+			ContentType("application/json", nil),
+			header("Content-Type", "text/plain; charset=utf-8"),
+			`Content-Type: want media type "application/json", got "text/plain" in "text/plain; charset=utf-8"`,
+		},
+		{
+			"Missing param",
+			ContentType("text/html", utf8),
+			header("Content-Type", "text/html"),
+			`Content-Type: want charset="utf-8", got no charset parameter in "text/html"`,
+		},
+		{
+			"Wrong param",
+			ContentType("text/html", utf8),
+			header("Content-Type", "text/html; charset=iso-8859-1"),
+			`Content-Type: want charset="utf-8", got charset="iso-8859-1"`,
+		},
+		{
+			"Case-sensitive param",
+			ContentType("multipart/form-data", map[string]string{"boundary": "ABC"}),
+			header("Content-Type", "multipart/form-data; boundary=abc"),
+			`Content-Type: want boundary="ABC", got boundary="abc"`,
+		},
+		{
+			"Unparsable",
+			ContentType("text/html", nil),
+			header("Content-Type", "text/html; charset"),
+			`Content-Type: cannot parse "text/html; charset": mime: invalid media parameter`,
+		},
+	})
+}
+
+func TestCORS(t *testing.T) {
+	t.Parallel()
+	const origin = "https://app.example"
+	runCases(t, []checkCase{
+		{"Origin", CORS(origin), header("Access-Control-Allow-Origin", origin), ""},
+		{"Wildcard", CORS(origin), header("Access-Control-Allow-Origin", "*"), ""},
+		{
+			"Methods",
+			CORS(origin, "GET", "POST"),
+			header(
+				"Access-Control-Allow-Origin",
+				origin,
+				"Access-Control-Allow-Methods",
+				"GET, POST, PUT",
+			),
+			"",
+		},
+		{
+			"Methods split across lines",
+			CORS(origin, "GET", "POST"),
+			header(
+				"Access-Control-Allow-Origin", origin,
+				"Access-Control-Allow-Methods", "GET",
+				"Access-Control-Allow-Methods", "POST",
+			),
+			"",
+		},
+		{
+			"Wildcard methods",
+			CORS(origin, "DELETE"),
+			header("Access-Control-Allow-Origin", origin, "Access-Control-Allow-Methods", "*"),
+			"",
+		},
+		{
+			"No origin",
+			CORS(origin),
+			header(),
+			`Access-Control-Allow-Origin: want "https://app.example", got no header`,
+		},
+		{
+			"Other origin",
+			CORS(origin),
+			header("Access-Control-Allow-Origin", "https://evil.example"),
+			`Access-Control-Allow-Origin: want "https://app.example", got "https://evil.example"`,
+		},
+		{
+			"No methods",
+			CORS(origin, "GET"),
+			header("Access-Control-Allow-Origin", origin),
+			`Access-Control-Allow-Methods: want ["GET"], got no header`,
+		},
+		{
+			"Missing methods",
+			CORS(origin, "GET", "DELETE", "PATCH"),
+			header(
+				"Access-Control-Allow-Origin",
+				origin,
+				"Access-Control-Allow-Methods",
+				"GET,POST",
+			),
+			`Access-Control-Allow-Methods: missing ["DELETE" "PATCH"] in ["GET" "POST"]`,
+		},
+	})
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+	secure := func(pairs ...string) http.Header {
+		h := header(
+			"Strict-Transport-Security", "max-age=63072000; includeSubDomains; preload",
+			"X-Content-Type-Options", "nosniff",
+			"Content-Security-Policy", "default-src 'self'",
+		)
+		for i := 0; i+1 < len(pairs); i += 2 {
+			if pairs[i+1] == "" {
+				h.Del(pairs[i])
+			} else {
+				h.Set(pairs[i], pairs[i+1])
+			}
+		}
+		return h
+	}
+	runCases(t, []checkCase{
+		{"All present", SecurityHeaders(), secure(), ""},
+		{"Case", SecurityHeaders(), secure("X-Content-Type-Options", "NoSniff"), ""},
+		{
+			"No HSTS",
+			SecurityHeaders(),
+			secure("Strict-Transport-Security", ""),
+			"Strict-Transport-Security: want a max-age, got no header",
+		},
+		{
+			"HSTS without max-age",
+			SecurityHeaders(),
+			secure("Strict-Transport-Security", "includeSubDomains"),
+			`Strict-Transport-Security: want a max-age, got "includeSubDomains"`,
+		},
+		{
+			"HSTS disabled",
+			SecurityHeaders(),
+			secure("Strict-Transport-Security", "max-age=0"),
+			`Strict-Transport-Security: want a positive max-age, got "0"`,
+		},
+		{
+			"Wrong nosniff",
+			SecurityHeaders(),
+			secure("X-Content-Type-Options", "sniff"),
+			`X-Content-Type-Options: want "nosniff", got "sniff"`,
+		},
+		{
+			"No CSP",
+			SecurityHeaders(),
+			secure("Content-Security-Policy", ""),
+			"Content-Security-Policy: want a policy, got no header",
+		},
+		{
+			"None",
+			SecurityHeaders(),
+			header(),
+			"Strict-Transport-Security: want a max-age, got no header\n" +
+				`X-Content-Type-Options: want "nosniff", got no header` + "\n" +
+				"Content-Security-Policy: want a policy, got no header",
+		},
+	})
+}
+
+func TestCacheControl(t *testing.T) {
+	t.Parallel()
+	runCases(t, []checkCase{
+		{"Bare", CacheControl("no-store"), header("Cache-Control", "no-store"), ""},
+		{
+			"Several",
+			CacheControl("private", "max-age=60"),
+			header("Cache-Control", "private, max-age=60"),
+			"",
+		},
+		{"Any value", CacheControl("max-age"), header("Cache-Control", "public, max-age=3600"), ""},
+		{"Case", CacheControl("no-cache"), header("Cache-Control", "No-Cache"), ""},
+		{
+			"Quoted",
+			CacheControl(`no-cache=Set-Cookie`),
+			header("Cache-Control", `no-cache="Set-Cookie"`),
+			"",
+		},
+		{
+			"Quoted list",
+			CacheControl(`private="a,b"`, "max-age=60"),
+			header("Cache-Control", `private="a,b", max-age=60`),
+			"",
+		},
+		{
+			"Separator in quotes",
+			CacheControl("no-store"),
+			header("Cache-Control", `private="x,no-store"`),
+			`Cache-Control: missing directive "no-store" in "private=\"x,no-store\""`,
+		},
+		{
+			"Across lines",
+			CacheControl("no-store", "must-revalidate"),
+			header("Cache-Control", "no-store", "Cache-Control", "must-revalidate"),
+			"",
+		},
+		{
+			"Missing header",
+			CacheControl("no-store"),
+			header(),
+			`Cache-Control: want ["no-store"], got no header`,
+		},
+		{
+			"Missing directive",
+			CacheControl("no-store"),
+			header("Cache-Control", "public, max-age=60"),
+			`Cache-Control: missing directive "no-store" in "public, max-age=60"`,
+		},
+		{
+			"Wrong value",
+			CacheControl("max-age=60"),
+			header("Cache-Control", "public, max-age=30"),
+			"Cache-Control: want max-age=60, got max-age=30",
+		},
+	})
+}
+
+func TestDirectives(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]string
+	}{
+		{
+			"Cache-Control",
+			`private="a,b", max-age=60`,
+			map[string]string{"private": "a,b", "max-age": "60"},
+		},
+		{
+			"Content-Disposition",
+			`attachment; filename="x;y"`,
+			map[string]string{"attachment": "", "filename": "x;y"},
+		},
+		{"Quoted pair", `a="x\"y,z", b`, map[string]string{"a": `x"y,z`, "b": ""}},
+		{"Empty", "", map[string]string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := directives(tc.raw); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCookie(t *testing.T) {
+	t.Parallel()
+	const full = "session=abc; Path=/; Domain=.example.com; Secure; HttpOnly; SameSite=Strict"
+	all := CookieAttributes{
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
+		Domain:   "example.com",
+	}
+	runCases(t, []checkCase{
+		{"All attributes", Cookie("session", all), header("Set-Cookie", full), ""},
+		{
+			"Name only",
+			Cookie("session", CookieAttributes{}),
+			header("Set-Cookie", "session=abc"),
+			"",
+		},
+		{
+			"Among several",
+			Cookie("session", CookieAttributes{Secure: true}),
+			header("Set-Cookie", "theme=dark", "Set-Cookie", "session=abc; Secure"),
+			"",
+		},
+		{
+			"No Set-Cookie",
+			Cookie("session", all),
+			header(),
+			`Set-Cookie: want cookie "session", got no header`,
+		},
+		{
+			"Other cookies",
+			Cookie("session", all),
+			header("Set-Cookie", "theme=dark", "Set-Cookie", "lang=en"),
+			`Set-Cookie: want cookie "session", got cookies ["theme" "lang"]`,
+		},
+		{
+			"Bare cookie",
+			Cookie("session", all),
+			header("Set-Cookie", "session=abc; SameSite=Lax; Path=/app; Domain=other.example"),
+			`Set-Cookie: cookie "session": missing Secure, missing HttpOnly, want SameSite=Strict, got Lax, ` +
+				`want Path="/", got "/app", want Domain="example.com", got "other.example"`,
+		},
+		{
+			"Unset SameSite",
+			Cookie("session", CookieAttributes{SameSite: http.SameSiteNoneMode}),
+			header("Set-Cookie", "session=abc"),
+			`Set-Cookie: cookie "session": want SameSite=None, got unset`,
+		},
+	})
+}
+
+func TestRequire(t *testing.T) {
+	t.Parallel()
+	h := header(
+		"Content-Type", "application/json",
+		"Cache-Control", "no-store",
+		"Access-Control-Allow-Origin", "*",
+		"Set-Cookie", "session=abc; Secure; HttpOnly",
+		"Strict-Transport-Security", "max-age=60",
+		"X-Content-Type-Options", "nosniff",
+		"Content-Security-Policy", "default-src 'none'",
+	)
+
+	helpers := []struct {
+		name string
+		fn   func(testing.TB)
+	}{
+		{
+			"RequireHeader",
+			func(tb testing.TB) { RequireHeader(tb, h, "Content-Type", "application/json") },
+		},
+		{"RequireHeaderAbsent", func(tb testing.TB) { RequireHeaderAbsent(tb, h, "Server") }},
+		{
+			"RequireContentType",
+			func(tb testing.TB) { RequireContentType(tb, h, "application/json", nil) },
+		},
+		{"RequireCORS", func(tb testing.TB) { RequireCORS(tb, h, "https://a.example") }},
+		{"RequireSecurityHeaders", func(tb testing.TB) { RequireSecurityHeaders(tb, h) }},
+		{"RequireCacheControl", func(tb testing.TB) { RequireCacheControl(tb, h, "no-store") }},
+		{
+			"RequireCookie",
+			func(tb testing.TB) { RequireCookie(tb, h, "session", CookieAttributes{Secure: true}) },
+		},
+	}
+	for _, tc := range helpers {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.fn(t)
+		})
+	}
+
+	t.Run("All pass", func(t *testing.T) {
+		t.Parallel()
+		Require(t, h,
+			ContentType("application/json", nil),
+			CacheControl("no-store"),
+			CORS("https://app.example"),
+			Cookie("session", CookieAttributes{Secure: true, HttpOnly: true}),
+			SecurityHeaders(),
+		)
+		RequireHeader(t, h, "Cache-Control", "no-store")
+		RequireHeaderAbsent(t, h, "Server")
+	})
+
+	t.Run("One failure", func(t *testing.T) {
+		t.Parallel()
+		msg := failure(h, []Check{Header("X-A", "1"), CacheControl("no-store")})
+		if want := `headers: X-A: want "1", got no header`; msg != want {
+			t.Errorf("Expected message %q, got %q", want, msg)
+		}
+	})
+
+	t.Run("Several failures", func(t *testing.T) {
+		t.Parallel()
+		msg := failure(h, []Check{
+			Header("X-A", "1"),
+			HeaderAbsent("Content-Type"),
+			CacheControl("no-store"),
+		})
+		want := "headers: 2 mismatches\n" +
+			`  - X-A: want "1", got no header` + "\n" +
+			`  - Content-Type: want no header, got ["application/json"]`
+		if msg != want {
+			t.Errorf("Expected message:\n%s\ngot:\n%s", want, msg)
+		}
+	})
+}
@@ -0,0 +1,3 @@
+module github.com/madflojo/testlazy/validators/httpstatus
+
+go 1.24.3
@@ -0,0 +1,281 @@
+/*
+Package httpstatus asserts on the status code of an HTTP response and, when
+the check fails, prints enough of the exchange to see why without reaching
+for a debugger.
+
+	github.com/madflojo/testlazy/validators/httpstatus
+
+Every helper accepts either an *http.Response from a client or an
+*httptest.ResponseRecorder from a handler test, so the same assertion works
+on both sides of the wire.
+
+Example usage
+
+	rec := httptest.NewRecorder()
+	req := testurl.Request(http.MethodGet, testurl.URLHTTP())
+	handler.ServeHTTP(rec, req)
+	httpstatus.RequireStatus(t, rec, http.StatusOK, httpstatus.WithRequest(req))
+
+On failure the message carries the request method and URL, the status, the
+response headers, and the body trimmed to MaxBodyBytes. A client response
+knows its request but a recorder does not, so pass the request with
+WithRequest to see it in the message.
+*/
+package httpstatus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// MaxBodyBytes is how much of the response body a failure message includes.
+const MaxBodyBytes = 512
+
+// Response is the set of response types the helpers accept.
+type Response interface {
+	*http.Response | *httptest.ResponseRecorder
+}
+
+// Option configures an assertion.
+type Option func(*config)
+
+// config collects the options of an assertion.
+type config struct {
+	req *http.Request
+}
+
+// WithRequest sets the request that produced the response, so failure
+// messages show its method and URL and RequireRedirectTo can resolve
+// relative locations. An *httptest.ResponseRecorder does not know its
+// request, so pass it here; for an *http.Response it replaces
+// resp.Request.
+func WithRequest(req *http.Request) Option {
+	return func(c *config) {
+		c.req = req
+	}
+}
+
+// RequireStatus fails the test unless resp has the status code code.
+func RequireStatus[R Response](t testing.TB, resp R, code int, opts ...Option) {
+	t.Helper()
+	if msg := statusFailure(result(resp), statusIs(code), opts); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequireSuccess fails the test unless resp has a 2xx status code.
+func RequireSuccess[R Response](t testing.TB, resp R, opts ...Option) {
+	t.Helper()
+	if msg := statusFailure(result(resp), success, opts); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequireClientError fails the test unless resp has a 4xx status code.
+func RequireClientError[R Response](t testing.TB, resp R, opts ...Option) {
+	t.Helper()
+	if msg := statusFailure(result(resp), clientError, opts); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequireServerError fails the test unless resp has a 5xx status code.
+func RequireServerError[R Response](t testing.TB, resp R, opts ...Option) {
+	t.Helper()
+	if msg := statusFailure(result(resp), serverError, opts); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequireRedirectTo fails the test unless resp has a 3xx status code and a
+// Location header naming target. When the request URL is known, a relative
+// Location or target is resolved against it before comparing, so "/next"
+// matches "https://example.com/next".
+func RequireRedirectTo[R Response](t testing.TB, resp R, target string, opts ...Option) {
+	t.Helper()
+	if msg := redirectFailure(result(resp), target, opts); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// statusCheck is the status an assertion expects: want describes it in the
+// failure message and ok accepts a status code.
+type statusCheck struct {
+	want string
+	ok   func(int) bool
+}
+
+// The status classes checked by RequireSuccess, RequireClientError, and
+// RequireServerError.
+var (
+	success     = statusCheck{"a 2xx success status", inClass(2)}
+	clientError = statusCheck{"a 4xx client error status", inClass(4)}
+	serverError = statusCheck{"a 5xx server error status", inClass(5)}
+)
+
+// statusIs returns a check for exactly code.
+func statusIs(code int) statusCheck {
+	return statusCheck{fmt.Sprintf("status %s", statusText(code)), func(got int) bool {
+		return got == code
+	}}
+}
+
+// statusFailure returns the message a status assertion fails with, or "" if
+// check accepts the status code of res.
+func statusFailure(res *http.Response, check statusCheck, opts []Option) string {
+	if res == nil {
+		return "httpstatus: response is nil"
+	}
+	if check.ok(res.StatusCode) {
+		return ""
+	}
+	summary := fmt.Sprintf("expected %s, got %s", check.want, statusText(res.StatusCode))
+	return describe(res, request(res, opts), summary)
+}
+
+// redirectFailure returns the message RequireRedirectTo fails with, or "" if
+// res redirects to target.
+func redirectFailure(res *http.Response, target string, opts []Option) string {
+	if res == nil {
+		return "httpstatus: response is nil"
+	}
+	req := request(res, opts)
+	if !inClass(3)(res.StatusCode) {
+		return describe(res, req, fmt.Sprintf("expected a 3xx redirect to %q", target))
+	}
+
+	location := res.Header.Get("Location")
+	if !sameLocation(req, location, target) {
+		summary := fmt.Sprintf("expected a redirect to %q, got Location %q", target, location)
+		return describe(res, req, summary)
+	}
+	return ""
+}
+
+// request returns the request set by opts, falling back to the request
+// recorded on res.
+func request(res *http.Response, opts []Option) *http.Request {
+	c := &config{req: res.Request}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c.req
+}
+
+// result returns resp as an *http.Response, or nil if resp is nil.
+func result[R Response](resp R) *http.Response {
+	switch r := any(resp).(type) {
+	case *http.Response:
+		return r
+	case *httptest.ResponseRecorder:
+		if r == nil {
+			return nil
+		}
+		return r.Result()
+	}
+	return nil
+}
+
+// inClass returns a check for status codes in the given hundred, such as 2
+// for 2xx.
+func inClass(class int) func(int) bool {
+	return func(code int) bool {
+		return code/100 == class
+	}
+}
+
+// statusText formats code as "404 Not Found".
+func statusText(code int) string {
+	if text := http.StatusText(code); text != "" {
+		return fmt.Sprintf("%d %s", code, text)
+	}
+	return fmt.Sprintf("%d", code)
+}
+
+// sameLocation reports whether location and target name the same URL,
+// resolving both against the request URL when there is one.
+func sameLocation(req *http.Request, location, target string) bool {
+	if location == target {
+		return true
+	}
+	if req == nil || req.URL == nil {
+		return false
+	}
+	l, err := req.URL.Parse(location)
+	if err != nil {
+		return false
+	}
+	g, err := req.URL.Parse(target)
+	if err != nil {
+		return false
+	}
+	return l.String() == g.String()
+}
+
+// describe builds a failure message headed by summary that shows req and
+// the status, headers, and trimmed body of res. The body of res is restored
+// so later reads still see it.
+func describe(res *http.Response, req *http.Request, summary string) string {
+	var b strings.Builder
+	b.WriteString("httpstatus: " + summary)
+
+	request := "unknown"
+	if req != nil && req.URL != nil {
+		request = req.Method + " " + req.URL.Redacted()
+	}
+	fmt.Fprintf(&b, "\n  request: %s", request)
+	fmt.Fprintf(&b, "\n  status:  %s", statusText(res.StatusCode))
+
+	keys := make([]string, 0, len(res.Header))
+	for k := range res.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b.WriteString("\n  headers:")
+	if len(keys) == 0 {
+		b.WriteString(" none")
+	}
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\n    %s: %s", k, strings.Join(res.Header[k], ", "))
+	}
+
+	fmt.Fprintf(&b, "\n  body:    %s", readBody(res))
+	return b.String()
+}
+
+// readBody returns at most MaxBodyBytes of the body of res, quoted, and
+// puts the bytes it read back in front of the rest so later reads still see
+// the whole body. It never reads further, so a large or streaming body
+// cannot stall the test.
+func readBody(res *http.Response) string {
+	if res.Body == nil || res.Body == http.NoBody {
+		return "empty"
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, MaxBodyBytes+1))
+	res.Body = readCloser{io.MultiReader(bytes.NewReader(data), res.Body), res.Body}
+
+	var suffix string
+	if err != nil {
+		suffix = fmt.Sprintf(" (read error: %v)", err)
+	}
+	if len(data) == 0 {
+		return "empty" + suffix
+	}
+	if len(data) > MaxBodyBytes {
+		suffix = " (truncated)" + suffix
+		data = data[:MaxBodyBytes]
+	}
+	return fmt.Sprintf("%q", data) + suffix
+}
+
+// readCloser pairs a Reader with the Closer of the body it replaces.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
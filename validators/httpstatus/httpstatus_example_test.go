@@ -0,0 +1,41 @@
+package httpstatus
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ExampleRequireStatus checks the status a handler wrote to a recorder.
+func ExampleRequireStatus() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/widgets", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	RequireStatus(t, rec, http.StatusCreated, WithRequest(req))
+	RequireSuccess(t, rec)
+	fmt.Println(rec.Code)
+	// Output: 201
+}
+
+// ExampleRequireRedirectTo resolves a relative Location against the request.
+func ExampleRequireRedirectTo() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/login", http.StatusFound)
+	})
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/account", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	RequireRedirectTo(t, rec, "https://example.com/login", WithRequest(req))
+	fmt.Println(rec.Header().Get("Location"))
+	// Output: /login
+}
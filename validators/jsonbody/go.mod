@@ -0,0 +1,3 @@
+module github.com/madflojo/testlazy/validators/jsonbody
+
+go 1.24.3
@@ -0,0 +1,501 @@
+/*
+Package jsonbody compares JSON bodies by structure instead of by string, so
+key order and whitespace never break a test, and prints a diff annotated with
+the JSON Pointer path of every difference.
+
+	github.com/madflojo/testlazy/validators/jsonbody
+
+The body can be a []byte, a string, an io.Reader, an *http.Response, or an
+*httptest.ResponseRecorder. Expected values can use placeholders such as
+AnyUUID wherever a value is not known in advance.
+
+Example usage
+
+	jsonbody.RequireEqual(t, rec, `{
+	    "id":      "<any uuid>",
+	    "name":    "gopher",
+	    "created": "<any rfc3339 time>"
+	}`)
+
+	jsonbody.RequireSubset(t, resp, map[string]any{"name": "gopher"})
+	jsonbody.RequirePointer(t, resp, "/tags/0", "mascot")
+
+Only encoding/json is used to decode, with numbers kept exact through
+json.Number.
+*/
+package jsonbody
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Placeholders match any value of a kind. Use them as string values in
+// expected JSON text or Go values.
+const (
+	// Any matches any value, including null, but the key must be present.
+	Any = "<any>"
+
+	// AnyString matches any JSON string.
+	AnyString = "<any string>"
+
+	// AnyNumber matches any JSON number.
+	AnyNumber = "<any number>"
+
+	// AnyUUID matches a string in the canonical 8-4-4-4-12 hex UUID form.
+	AnyUUID = "<any uuid>"
+
+	// AnyRFC3339 matches a string that time.Parse accepts with time.RFC3339.
+	AnyRFC3339 = "<any rfc3339 time>"
+)
+
+// maxBodyBytes is how much of an unparsable body a failure message includes.
+const maxBodyBytes = 512
+
+var uuidPattern = regexp.MustCompile(
+	`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+)
+
+// RequireEqual fails the test unless body and want hold the same JSON value,
+// ignoring object key order and whitespace. want is JSON text when it is a
+// string, []byte, or json.RawMessage, and any other Go value is encoded with
+// encoding/json first.
+func RequireEqual(t testing.TB, body, want any) {
+	t.Helper()
+	if msg := matchFailure(body, want, false); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequireSubset is like RequireEqual, except that objects in body may hold
+// keys that want does not mention. Arrays must still have the same length,
+// with each element compared as a subset.
+func RequireSubset(t testing.TB, body, want any) {
+	t.Helper()
+	if msg := matchFailure(body, want, true); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequirePointer fails the test unless the value at the RFC 6901 JSON
+// Pointer in body equals want. Unlike RequireEqual, want is always a Go
+// value, so "abc" means the JSON string "abc"; pass a json.RawMessage or
+// []byte to give JSON text instead.
+func RequirePointer(t testing.TB, body any, pointer string, want any) {
+	t.Helper()
+	if msg := pointerFailure(body, pointer, want); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// RequirePointerAbsent fails the test if the RFC 6901 JSON Pointer resolves
+// to a value in body.
+func RequirePointerAbsent(t testing.TB, body any, pointer string) {
+	t.Helper()
+	if msg := absentFailure(body, pointer); msg != "" {
+		t.Fatal(msg)
+	}
+}
+
+// matchFailure returns the message RequireEqual, or RequireSubset when
+// subset is true, fails with, or "" if body matches want.
+func matchFailure(body, want any, subset bool) string {
+	got, err := decodeBody(body)
+	if err != nil {
+		return "jsonbody: " + err.Error()
+	}
+	w, err := decodeWant(want, true)
+	if err != nil {
+		return "jsonbody: " + err.Error()
+	}
+
+	diffs := compare("", w, got, subset)
+	switch {
+	case len(diffs) == 0:
+		return ""
+	case subset:
+		return report("body does not contain the expected subset", diffs)
+	default:
+		return report("bodies differ", diffs)
+	}
+}
+
+// pointerFailure returns the message RequirePointer fails with, or "" if
+// the value at pointer equals want.
+func pointerFailure(body any, pointer string, want any) string {
+	doc, err := decodeBody(body)
+	if err != nil {
+		return "jsonbody: " + err.Error()
+	}
+	got, err := Lookup(doc, pointer)
+	if err != nil {
+		return "jsonbody: " + err.Error()
+	}
+	w, err := decodeWant(want, false)
+	if err != nil {
+		return "jsonbody: " + err.Error()
+	}
+	if diffs := compare(pointer, w, got, false); len(diffs) > 0 {
+		return report("value at pointer differs", diffs)
+	}
+	return ""
+}
+
+// absentFailure returns the message RequirePointerAbsent fails with, or ""
+// if pointer does not resolve in body.
+func absentFailure(body any, pointer string) string {
+	doc, err := decodeBody(body)
+	if err != nil {
+		return "jsonbody: " + err.Error()
+	}
+	if got, err := Lookup(doc, pointer); err == nil {
+		return fmt.Sprintf("jsonbody: %s: want absent, got %s", displayPath(pointer), format(got))
+	}
+	return ""
+}
+
+// Lookup resolves an RFC 6901 JSON Pointer, such as "/users/0/name", against
+// a decoded JSON document. The empty pointer refers to the whole document,
+// and "~1" and "~0" in a reference token stand for "/" and "~".
+func Lookup(doc any, pointer string) (any, error) {
+	if pointer == "" {
+		return doc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("pointer %q must be empty or start with \"/\"", pointer)
+	}
+
+	cur := doc
+	path := ""
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := cur.(type) {
+		case map[string]any:
+			next, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("%s: no key %q", displayPath(path), token)
+			}
+			cur = next
+		case []any:
+			i, err := arrayIndex(token)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", displayPath(path), err)
+			}
+			if i >= len(v) {
+				return nil, fmt.Errorf("%s: index %d out of range for %d items", displayPath(path), i, len(v))
+			}
+			cur = v[i]
+		default:
+			return nil, fmt.Errorf("%s: cannot index %s with %q", displayPath(path), kind(cur), token)
+		}
+		path += "/" + escapeToken(token)
+	}
+	return cur, nil
+}
+
+// arrayIndex parses an RFC 6901 array index, which has no sign and no
+// leading zeros.
+func arrayIndex(token string) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') ||
+		strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return i, nil
+}
+
+// decodeWant decodes an expected value. A json.RawMessage or []byte is JSON
+// text, as is a string when text is true; anything else is a Go value that
+// is encoded first.
+func decodeWant(want any, text bool) (any, error) {
+	var data []byte
+	switch v := want.(type) {
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	case string:
+		if !text {
+			return v, nil
+		}
+		data = []byte(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode expected value: %w", err)
+		}
+		data = b
+	}
+	w, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("expected value is not valid JSON: %w", err)
+	}
+	return w, nil
+}
+
+// decodeBody reads and decodes body, returning an error if it is not valid
+// JSON or of an unsupported type.
+func decodeBody(body any) (any, error) {
+	data, err := readBody(body)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := decode(data)
+	if err != nil {
+		shown := data
+		suffix := ""
+		if len(shown) > maxBodyBytes {
+			suffix = fmt.Sprintf(" (%d more bytes)", len(shown)-maxBodyBytes)
+			shown = shown[:maxBodyBytes]
+		}
+		return nil, fmt.Errorf("body is not valid JSON: %v\n  body: %q%s", err, shown, suffix)
+	}
+	return doc, nil
+}
+
+// readBody returns the bytes of a supported body type. An *http.Response
+// body is restored after reading.
+func readBody(body any) ([]byte, error) {
+	switch v := body.(type) {
+	case []byte:
+		return v, nil
+	case json.RawMessage:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	case *httptest.ResponseRecorder:
+		if v == nil {
+			return nil, errors.New("response recorder is nil")
+		}
+		return v.Body.Bytes(), nil
+	case *http.Response:
+		if v == nil {
+			return nil, errors.New("response is nil")
+		}
+		if v.Body == nil {
+			return nil, nil
+		}
+		data, err := io.ReadAll(v.Body)
+		_ = v.Body.Close()
+		v.Body = io.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("reading response body: %w", err)
+		}
+		return data, nil
+	case io.Reader:
+		data, err := io.ReadAll(v)
+		if err != nil {
+			return nil, fmt.Errorf("reading body: %w", err)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported body type %T", body)
+	}
+}
+
+// decode parses exactly one JSON value from data, keeping numbers as
+// json.Number.
+func decode(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("empty input")
+		}
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the top-level value")
+	}
+	return v, nil
+}
+
+// compare returns a line for every difference between want and got below
+// path. In subset mode, extra object keys in got are allowed.
+func compare(path string, want, got any, subset bool) []string {
+	if s, ok := want.(string); ok {
+		if matched, handled := matchPlaceholder(s, got); handled {
+			if matched {
+				return nil
+			}
+			return []string{fmt.Sprintf("%s: want %s, got %s", displayPath(path), s, format(got))}
+		}
+	}
+
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return mismatch(path, want, got)
+		}
+		var diffs []string
+		for _, k := range slices.Sorted(maps.Keys(w)) {
+			child := path + "/" + escapeToken(k)
+			gv, ok := g[k]
+			if !ok {
+				diffs = append(diffs, fmt.Sprintf("%s: missing, want %s", displayPath(child), format(w[k])))
+				continue
+			}
+			diffs = append(diffs, compare(child, w[k], gv, subset)...)
+		}
+		if !subset {
+			for _, k := range slices.Sorted(maps.Keys(g)) {
+				if _, ok := w[k]; !ok {
+					child := path + "/" + escapeToken(k)
+					diffs = append(diffs, fmt.Sprintf("%s: unexpected, got %s", displayPath(child), format(g[k])))
+				}
+			}
+		}
+		return diffs
+
+	case []any:
+		g, ok := got.([]any)
+		if !ok {
+			return mismatch(path, want, got)
+		}
+		var diffs []string
+		if len(w) != len(g) {
+			diffs = append(diffs, fmt.Sprintf("%s: want %d items, got %d", displayPath(path), len(w), len(g)))
+		}
+		for i := range min(len(w), len(g)) {
+			diffs = append(diffs, compare(path+"/"+strconv.Itoa(i), w[i], g[i], subset)...)
+		}
+		return diffs
+
+	case json.Number:
+		g, ok := got.(json.Number)
+		if !ok {
+			return mismatch(path, want, got)
+		}
+		equal, err := equalNumbers(w, g)
+		if err != nil {
+			return []string{fmt.Sprintf("%s: %v", displayPath(path), err)}
+		}
+		if !equal {
+			return mismatch(path, want, got)
+		}
+		return nil
+
+	default:
+		if want != got {
+			return mismatch(path, want, got)
+		}
+		return nil
+	}
+}
+
+// matchPlaceholder reports whether got matches the placeholder s, and
+// whether s was a placeholder at all.
+func matchPlaceholder(s string, got any) (matched, handled bool) {
+	switch s {
+	case Any:
+		return true, true
+	case AnyString:
+		_, ok := got.(string)
+		return ok, true
+	case AnyNumber:
+		_, ok := got.(json.Number)
+		return ok, true
+	case AnyUUID:
+		g, ok := got.(string)
+		return ok && uuidPattern.MatchString(g), true
+	case AnyRFC3339:
+		g, ok := got.(string)
+		if !ok {
+			return false, true
+		}
+		_, err := time.Parse(time.RFC3339, g)
+		return err == nil, true
+	default:
+		return false, false
+	}
+}
+
+// equalNumbers compares JSON numbers by exact value, so 1, 1.0, and 1e0 are
+// equal while integers beyond the precision of float64 still differ.
+// Identical literals are always equal; it returns an error when the values
+// differ in form but an exponent is too large for math/big to compare them.
+func equalNumbers(want, got json.Number) (bool, error) {
+	if want == got {
+		return true, nil
+	}
+	w, okW := new(big.Rat).SetString(string(want))
+	g, okG := new(big.Rat).SetString(string(got))
+	if !okW || !okG {
+		return false, fmt.Errorf("cannot compare numbers exactly, want %s, got %s", want, got)
+	}
+	return w.Cmp(g) == 0, nil
+}
+
+// mismatch returns the single diff line for differing values at path.
+func mismatch(path string, want, got any) []string {
+	return []string{
+		fmt.Sprintf("%s: want %s, got %s", displayPath(path), format(want), format(got)),
+	}
+}
+
+// report joins diff lines under a summary.
+func report(summary string, diffs []string) string {
+	return "jsonbody: " + summary + "\n  " + strings.Join(diffs, "\n  ")
+}
+
+// format renders a decoded value as compact JSON, without escaping HTML
+// characters, so placeholders stay readable.
+func format(v any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// kind names the JSON type of a decoded value.
+func kind(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+// displayPath shows the root pointer as "(root)" so it is visible.
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+// escapeToken escapes a key for use as an RFC 6901 reference token.
+func escapeToken(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,57 @@
+package jsonbody
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+)
+
+// ExampleRequireEqual matches a recorded body with placeholders for the
+// values a handler generates.
+func ExampleRequireEqual() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	rec := httptest.NewRecorder()
+	rec.WriteString(`{"name":"gopher","id":"8f14e45f-ceea-4672-a1b1-6e3e5bd8c7a4",` +
+		`"created":"2024-05-01T12:00:00Z"}`)
+
+	RequireEqual(t, rec, `{
+		"id":      "<any uuid>",
+		"name":    "gopher",
+		"created": "<any rfc3339 time>"
+	}`)
+	RequireSubset(t, rec, map[string]any{"name": "gopher"})
+	fmt.Println(t.Failed())
+	// Output: false
+}
+
+// ExampleRequirePointer checks single values without spelling out the rest
+// of the body.
+func ExampleRequirePointer() {
+	t := new(testing.T) // stands in for the *testing.T of a real test
+
+	body := `{"name":"gopher","tags":["mascot","blue"]}`
+
+	RequirePointer(t, body, "/tags/0", "mascot")
+	RequirePointerAbsent(t, body, "/tags/2")
+	fmt.Println(t.Failed())
+	// Output: false
+}
+
+// ExampleLookup resolves pointers against a decoded document.
+func ExampleLookup() {
+	var doc any
+	if err := json.Unmarshal([]byte(`{"users":[{"name":"gopher"}],"a/b":1}`), &doc); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(Lookup(doc, "/users/0/name"))
+	fmt.Println(Lookup(doc, "/a~1b"))
+	fmt.Println(Lookup(doc, "/users/1"))
+	// Output:
+	// gopher <nil>
+	// 1 <nil>
+	// <nil> /users: index 1 out of range for 1 items
+}
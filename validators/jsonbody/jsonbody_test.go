@@ -0,0 +1,308 @@
+package jsonbody
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+const user = `{
+	"id": "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+	"name": "gopher",
+	"age": 13,
+	"admin": false,
+	"created": "2009-11-10T23:00:00Z",
+	"tags": ["mascot", "blue"],
+	"manager": null,
+	"a/b": {"~key": 1}
+}`
+
+func TestRequireEqual(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want any
+		diff []string
+	}{
+		{
+			"Reordered and reformatted",
+			`{"a/b":{"~key":1},"tags":["mascot","blue"],"name":"gopher","manager":null,"id":` +
+				`"3f2504e0-4f89-11d3-9a0c-0305e82c3301","created":"2009-11-10T23:00:00Z","age":13,"admin":false}`,
+			nil,
+		},
+		{
+			"Placeholders",
+			`{"id":"<any uuid>","name":"<any string>","age":"<any number>","admin":"<any>",` +
+				`"created":"<any rfc3339 time>","tags":"<any>","manager":"<any>","a/b":"<any>"}`,
+			nil,
+		},
+		{
+			"Go value",
+			map[string]any{
+				"id": AnyUUID, "name": "gopher", "age": 13.0, "admin": false, "created": AnyRFC3339,
+				"tags": []string{
+					"mascot",
+					"blue",
+				}, "manager": nil, "a/b": map[string]int{"~key": 1},
+			},
+			nil,
+		},
+		{
+			"Differences",
+			`{"id":"<any uuid>","name":"gopher","age":"13","admin":false,"created":"<any rfc3339 time>",` +
+				`"tags":["mascot"],"manager":{},"a/b":{"~key":2},"email":"<any string>"}`,
+			[]string{
+				`/a~1b/~0key: want 2, got 1`,
+				`/age: want "13", got 13`,
+				`/email: missing, want "<any string>"`,
+				`/manager: want {}, got null`,
+				`/tags: want 1 items, got 2`,
+			},
+		},
+		{
+			"Unexpected key",
+			`{"id":"<any>","name":"<any>","age":"<any>","admin":"<any>","created":"<any>","tags":"<any>",` +
+				`"manager":"<any>"}`,
+			[]string{`/a~1b: unexpected, got {"~key":1}`},
+		},
+		{
+			"Placeholder mismatches",
+			`{"id":"<any number>","name":"<any uuid>","age":"<any string>","admin":"<any>",` +
+				`"created":"<any>","tags":["<any rfc3339 time>","<any>"],"manager":"<any string>","a/b":"<any>"}`,
+			[]string{
+				`/age: want <any string>, got 13`,
+				`/id: want <any number>, got "3f2504e0-4f89-11d3-9a0c-0305e82c3301"`,
+				`/manager: want <any string>, got null`,
+				`/name: want <any uuid>, got "gopher"`,
+				`/tags/0: want <any rfc3339 time>, got "mascot"`,
+			},
+		},
+		{"Root type", `[]`, []string{`(root): want [], got {`}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			msg := matchFailure(user, tc.want, false)
+			if (msg != "") != (len(tc.diff) > 0) {
+				t.Fatalf(
+					"Expected failure to be %t, got message:\n%s",
+					len(tc.diff) > 0,
+					msg,
+				)
+			}
+			for _, line := range tc.diff {
+				if !strings.Contains(msg, "\n  "+line) {
+					t.Errorf("Expected diff line %q in:\n%s", line, msg)
+				}
+			}
+			if msg != "" && !strings.HasPrefix(msg, "jsonbody: bodies differ\n") {
+				t.Errorf("Unexpected summary in:\n%s", msg)
+			}
+			if len(tc.diff) == 0 {
+				RequireEqual(t, user, tc.want)
+			}
+		})
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	t.Parallel()
+	RequireEqual(
+		t,
+		`[1, 1.5, 100, 12345678901234567890]`,
+		`[1.0, 15e-1, 1e2, 12345678901234567890]`,
+	)
+
+	msg := matchFailure(`{"n": 1}`, `{"n": 2}`, false)
+	if !strings.Contains(msg, "/n: want 2, got 1") {
+		t.Errorf("Unexpected message:\n%s", msg)
+	}
+
+	RequireEqual(t, `{"n": 1E99999999999}`, `{"n": 1E99999999999}`)
+
+	msg = matchFailure(`{"n": 1E99999999999}`, `{"n": 10E99999999998}`, false)
+	if !strings.Contains(msg, "/n: cannot compare numbers exactly") {
+		t.Errorf("Unexpected message:\n%s", msg)
+	}
+}
+
+// TestLargeNumbers guards against comparing through float64, which rounds
+// integers above 2^53 to the same value.
+func TestLargeNumbers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"Above 2^53", `{"id":9007199254740993}`, `{"id":9007199254740992}`},
+		{"Above 2^64", `{"id":12345678901234567891}`, `{"id":12345678901234567890}`},
+		{"Fraction", `{"id":0.30000000000000000001}`, `{"id":0.3}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			msg := matchFailure(tc.body, tc.want, false)
+			if msg == "" || !strings.Contains(msg, "/id: want") {
+				t.Errorf("Expected a mismatch on /id, got:\n%s", msg)
+			}
+		})
+	}
+}
+
+func TestRequireSubset(t *testing.T) {
+	t.Parallel()
+	RequireSubset(t, user, `{"name":"gopher","tags":["<any string>","blue"],"a/b":{}}`)
+	RequireSubset(t, user, map[string]any{"id": AnyUUID})
+
+	msg := matchFailure(user, `{"name":"gofer","tags":["mascot"],"missing":1}`, true)
+	for _, line := range []string{
+		`/missing: missing, want 1`,
+		`/name: want "gofer", got "gopher"`,
+		`/tags: want 1 items, got 2`,
+	} {
+		if !strings.Contains(msg, "\n  "+line) {
+			t.Errorf("Expected diff line %q in:\n%s", line, msg)
+		}
+	}
+	if !strings.HasPrefix(msg, "jsonbody: body does not contain the expected subset\n") {
+		t.Errorf("Unexpected summary in:\n%s", msg)
+	}
+}
+
+func TestRequirePointer(t *testing.T) {
+	t.Parallel()
+	RequirePointer(t, user, "/name", "gopher")
+	RequirePointer(t, user, "/tags/1", "blue")
+	RequirePointer(t, user, "/age", 13)
+	RequirePointer(t, user, "/manager", nil)
+	RequirePointer(t, user, "/a~1b/~0key", 1)
+	RequirePointer(t, user, "/a~1b", json.RawMessage(`{"~key": 1}`))
+	RequirePointer(t, user, "/id", AnyUUID)
+	RequirePointer(t, user, "/tags", []string{"mascot", "blue"})
+	RequirePointer(t, `"<any>"`, "", "<any>")
+	RequirePointerAbsent(t, user, "/email")
+	RequirePointerAbsent(t, user, "/tags/2")
+
+	tests := []struct {
+		name    string
+		msg     string
+		message string
+	}{
+		{
+			"Wrong value",
+			pointerFailure(user, "/tags/0", "blue"),
+			"jsonbody: value at pointer differs\n  /tags/0: want \"blue\", got \"mascot\"",
+		},
+		{"String is a value", pointerFailure(user, "/age", "13"), `/age: want "13", got 13`},
+		{"Missing key", pointerFailure(user, "/a~1b/key", 1), `jsonbody: /a~1b: no key "key"`},
+		{
+			"Out of range",
+			pointerFailure(user, "/tags/2", 1),
+			`jsonbody: /tags: index 2 out of range for 2 items`,
+		},
+		{
+			"Leading zero",
+			pointerFailure(user, "/tags/01", 1),
+			`jsonbody: /tags: invalid array index "01"`,
+		},
+		{"Dash", pointerFailure(user, "/tags/-", 1), `jsonbody: /tags: invalid array index "-"`},
+		{
+			"Scalar",
+			pointerFailure(user, "/name/0", 1),
+			`jsonbody: /name: cannot index string with "0"`,
+		},
+		{
+			"Relative",
+			pointerFailure(user, "name", 1),
+			`jsonbody: pointer "name" must be empty or start with "/"`,
+		},
+		{"Present", absentFailure(user, "/tags/0"), `jsonbody: /tags/0: want absent, got "mascot"`},
+		{
+			"Bad want",
+			pointerFailure(user, "/age", json.RawMessage(`{`)),
+			"jsonbody: expected value is not valid JSON",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if tc.msg == "" || !strings.Contains(tc.msg, tc.message) {
+				t.Errorf("Expected failure containing %q, got %q", tc.message, tc.msg)
+			}
+		})
+	}
+}
+
+func TestBodies(t *testing.T) {
+	t.Parallel()
+	const body = `{"ok": true}`
+
+	rec := httptest.NewRecorder()
+	_, _ = io.WriteString(rec, body)
+	RequireEqual(t, rec, `{"ok":true}`)
+
+	resp := rec.Result()
+	RequireEqual(t, resp, `{"ok":true}`)
+	data, _ := io.ReadAll(resp.Body)
+	if string(data) != body {
+		t.Errorf("Expected response body to be restored, got %q", data)
+	}
+
+	RequireEqual(t, []byte(body), `{"ok":true}`)
+	RequireEqual(t, json.RawMessage(body), []byte(`{"ok":true}`))
+	RequireEqual(t, strings.NewReader(body), json.RawMessage(`{"ok":true}`))
+}
+
+func TestInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		body    any
+		want    any
+		message string
+	}{
+		{
+			"Invalid body",
+			`{"a":`,
+			`{}`,
+			"jsonbody: body is not valid JSON: unexpected EOF\n  body: \"{\\\"a\\\":\"",
+		},
+		{"Trailing data", `{} {}`, `{}`, "unexpected data after the top-level value"},
+		{"Empty body", ``, `{}`, "body is not valid JSON: empty input"},
+		{"Long body", strings.Repeat("x", maxBodyBytes+5), `{}`, "(5 more bytes)"},
+		{"Invalid want", `{}`, `{`, "jsonbody: expected value is not valid JSON: unexpected EOF"},
+		{"Unencodable want", `{}`, make(chan int), "jsonbody: cannot encode expected value"},
+		{"Unsupported body", 42, `{}`, "jsonbody: unsupported body type int"},
+		{"Nil response", (*http.Response)(nil), `{}`, "jsonbody: response is nil"},
+		{
+			"Nil recorder",
+			(*httptest.ResponseRecorder)(nil),
+			`{}`,
+			"jsonbody: response recorder is nil",
+		},
+		{
+			"Read error",
+			iotest.ErrReader(errors.New("boom")),
+			`{}`,
+			"jsonbody: reading body: boom",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			msg := matchFailure(tc.body, tc.want, false)
+			if msg == "" || !strings.Contains(msg, tc.message) {
+				t.Errorf("Expected failure containing %q, got %q", tc.message, msg)
+			}
+		})
+	}
+}